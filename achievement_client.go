@@ -1,7 +1,9 @@
 package discord
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"unsafe"
 
 	core "github.com/andresperezl/discordgamesdk-go/core"
@@ -13,15 +15,15 @@ type AchievementClient struct {
 	core    *core.Core
 }
 
-// SetUserAchievement sets a user achievement
+// SetUserAchievement sets a user achievement without waiting for Discord to record it,
+// so it only returns an error if the manager is missing
+//
+// Deprecated: use SetProgress, which reports the result and respects context cancellation and timeout.
 func (ac *AchievementClient) SetUserAchievement(achievementID int64, percentComplete uint8) error {
 	if ac.manager == nil {
 		return fmt.Errorf("achievement manager not available")
 	}
-	res := ac.manager.SetUserAchievement(achievementID, percentComplete)
-	if res != core.ResultOk {
-		return fmt.Errorf("failed to set user achievement: %v", res)
-	}
+	ac.manager.SetUserAchievementAsync(achievementID, percentComplete, nil)
 	return nil
 }
 
@@ -61,10 +63,156 @@ func (ac *AchievementClient) GetUserAchievementCount() (int32, error) {
 	return count, nil
 }
 
-// FetchUserAchievements fetches user achievements asynchronously
+// FetchUserAchievements fetches user achievements asynchronously (callback usage is up to the user)
+//
+// Deprecated: use FetchAll, which waits for the fetch and returns the achievements.
 func (ac *AchievementClient) FetchUserAchievements(callbackData, callback unsafe.Pointer) {
 	if ac.manager == nil {
 		return
 	}
 	ac.manager.FetchUserAchievements(callbackData, callback)
 }
+
+// FetchAll fetches the current user's achievements, respecting context cancellation and timeout.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	achievements, err := client.Achievement().FetchAll(ctx)
+//	if err != nil {
+//	    log.Fatalf("failed to fetch achievements: %v", err)
+//	}
+//	for _, a := range achievements {
+//	    fmt.Printf("%d: %d%%\n", a.AchievementID, a.PercentComplete)
+//	}
+//
+// Returns the achievements or error if the context is cancelled, deadline exceeded, or the fetch fails.
+func (ac *AchievementClient) FetchAll(ctx context.Context) ([]core.UserAchievement, error) {
+	if ac.manager == nil {
+		return nil, fmt.Errorf("achievement manager not available")
+	}
+	errChan := make(chan error, 1)
+
+	ac.manager.FetchUserAchievementsAsync(func(result core.Result) {
+		if result != core.ResultOk {
+			errChan <- fmt.Errorf("failed to fetch user achievements: %v", result)
+			return
+		}
+		errChan <- nil
+	})
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			return nil, err
+		}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	count, err := ac.GetUserAchievementCount()
+	if err != nil {
+		return nil, err
+	}
+	achievements := make([]core.UserAchievement, 0, count)
+	for i := int32(0); i < count; i++ {
+		ach, err := ac.GetUserAchievementAt(i)
+		if err != nil {
+			return nil, err
+		}
+		achievements = append(achievements, *ach)
+	}
+	return achievements, nil
+}
+
+// SetProgress sets the current user's progress on an achievement, where 100 unlocks it,
+// and waits for Discord to record it, respecting context cancellation and timeout.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	if err := client.Achievement().SetProgress(ctx, achievementID, 100); err != nil {
+//	    log.Printf("failed to unlock achievement: %v", err)
+//	}
+//
+// Returns an error if percent is above 100, the context is cancelled, deadline exceeded, or the update fails.
+func (ac *AchievementClient) SetProgress(ctx context.Context, achievementID int64, percent uint8) error {
+	if ac.manager == nil {
+		return fmt.Errorf("achievement manager not available")
+	}
+	if percent > 100 {
+		return fmt.Errorf("achievement progress %d is above 100 percent", percent)
+	}
+	errChan := make(chan error, 1)
+
+	ac.manager.SetUserAchievementAsync(achievementID, percent, func(result core.Result) {
+		if result != core.ResultOk {
+			errChan <- fmt.Errorf("failed to set user achievement: %v", result)
+			return
+		}
+		errChan <- nil
+	})
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Updates returns a channel that receives the current user's achievements as their
+// progress changes, from the OnUserAchievementUpdate event. The channel is closed
+// and its event subscription removed when ctx is done, without affecting other subscribers.
+// Updates are dropped if the channel is full.
+//
+// Example usage:
+//
+//	for ach := range client.Achievement().Updates(ctx) {
+//	    if ach.PercentComplete == 100 {
+//	        showUnlockedToast(ach.AchievementID)
+//	    }
+//	}
+func (ac *AchievementClient) Updates(ctx context.Context) <-chan core.UserAchievement {
+	if ac.core == nil {
+		ch := make(chan core.UserAchievement)
+		close(ch)
+		return ch
+	}
+	return achievementUpdates(ctx, ac.core)
+}
+
+// achievementUpdates subscribes to the achievement events of source until ctx is done
+func achievementUpdates(ctx context.Context, source eventSource) <-chan core.UserAchievement {
+	ch := make(chan core.UserAchievement, 8)
+	var mu sync.Mutex
+	closed := false
+	unsubscribe := source.AddAchievementEvents(&core.AchievementEvents{
+		OnUserAchievementUpdate: func(ach *core.UserAchievement) {
+			if ach == nil {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if closed {
+				return
+			}
+			select {
+			case ch <- *ach:
+			default:
+			}
+		},
+	})
+
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+		mu.Lock()
+		defer mu.Unlock()
+		closed = true
+		close(ch)
+	}()
+	return ch
+}
@@ -0,0 +1,63 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleAchievementClient_FetchAll demonstrates how to use FetchAll with a timeout.
+// This example is for documentation only and requires a real, initialized AchievementClient.
+func ExampleAchievementClient_FetchAll() {
+	var achievementClient *AchievementClient // Assume this is properly initialized
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	achievements, err := achievementClient.FetchAll(ctx)
+	if err != nil {
+		log.Fatalf("failed to fetch achievements: %v", err)
+	}
+	for _, a := range achievements {
+		fmt.Printf("%d: %d%%\n", a.AchievementID, a.PercentComplete)
+	}
+	// No Output: (documentation only)
+}
+
+// ExampleAchievementClient_SetProgress demonstrates how to use SetProgress with a timeout.
+// This example is for documentation only and requires a real, initialized AchievementClient.
+func ExampleAchievementClient_SetProgress() {
+	var achievementClient *AchievementClient // Assume this is properly initialized
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := achievementClient.SetProgress(ctx, 123456789, 100); err != nil {
+		log.Fatalf("failed to set achievement progress: %v", err)
+	}
+	// No Output: (documentation only)
+}
+
+// ExampleAchievementClient_Updates demonstrates that each Updates channel has its own
+// subscription, so cancelling one leaves the others receiving.
+func ExampleAchievementClient_Updates() {
+	events := &testEvents{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	hud := achievementUpdates(ctx, events)
+	toastCtx, stopToasts := context.WithCancel(context.Background())
+	toasts := achievementUpdates(toastCtx, events)
+
+	events.userAchievementUpdate(core.UserAchievement{AchievementID: 1, PercentComplete: 50})
+	stopToasts()
+	for range toasts {
+	}
+	events.userAchievementUpdate(core.UserAchievement{AchievementID: 1, PercentComplete: 100})
+
+	fmt.Println((<-hud).PercentComplete, (<-hud).PercentComplete)
+	// Output:
+	// 50 100
+}
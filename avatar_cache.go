@@ -0,0 +1,235 @@
+package discord
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// defaultAvatarCacheCapacity is used when AvatarCacheOptions.Capacity is not set
+const defaultAvatarCacheCapacity = 128
+
+// AvatarCacheOptions configures an AvatarCache
+type AvatarCacheOptions struct {
+	// Capacity is the number of images kept in memory. Defaults to 128.
+	Capacity int
+	// Dir enables on-disk persistence of fetched images as PNG files when set
+	Dir string
+}
+
+// AvatarCache caches images fetched through an ImageClient, keyed by image handle
+// (type, ID and size). Recently used images are kept in memory, least recently
+// used ones are evicted first, and concurrent requests for the same handle share
+// a single fetch. It is safe for concurrent use.
+type AvatarCache struct {
+	fetch    func(ctx context.Context, handle core.ImageHandle, refresh bool) (*image.RGBA, error)
+	capacity int
+	dir      string
+
+	mu       sync.Mutex
+	entries  map[core.ImageHandle]*list.Element
+	lru      *list.List // front is most recently used
+	inflight map[avatarKey]*avatarCall
+}
+
+type avatarEntry struct {
+	handle core.ImageHandle
+	img    *image.RGBA
+}
+
+type avatarKey struct {
+	handle  core.ImageHandle
+	refresh bool
+}
+
+// avatarCall is a fetch shared by every caller waiting on the same key
+type avatarCall struct {
+	done    chan struct{}
+	img     *image.RGBA
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// NewAvatarCache creates an avatar cache that fetches through images.
+//
+// Example usage:
+//
+//	avatars := discord.NewAvatarCache(client.Image(), discord.AvatarCacheOptions{
+//	    Capacity: 256,
+//	    Dir:      filepath.Join(cacheDir, "avatars"),
+//	})
+//	img, err := avatars.Get(ctx, core.ImageHandle{Type: core.ImageTypeUser, ID: userID, Size: 64})
+func NewAvatarCache(images *ImageClient, opts AvatarCacheOptions) *AvatarCache {
+	return newAvatarCache(images.fetchImage, opts)
+}
+
+func newAvatarCache(fetch func(ctx context.Context, handle core.ImageHandle, refresh bool) (*image.RGBA, error), opts AvatarCacheOptions) *AvatarCache {
+	if opts.Capacity <= 0 {
+		opts.Capacity = defaultAvatarCacheCapacity
+	}
+	return &AvatarCache{
+		fetch:    fetch,
+		capacity: opts.Capacity,
+		dir:      opts.Dir,
+		entries:  make(map[core.ImageHandle]*list.Element),
+		lru:      list.New(),
+		inflight: make(map[avatarKey]*avatarCall),
+	}
+}
+
+// Get returns the image for handle from memory, then disk, and fetches it from
+// Discord only when neither has it. The returned image is shared and must not be modified.
+//
+// Returns the image or error if the context is cancelled, deadline exceeded, or the fetch fails.
+func (c *AvatarCache) Get(ctx context.Context, handle core.ImageHandle) (*image.RGBA, error) {
+	c.mu.Lock()
+	if elem, ok := c.entries[handle]; ok {
+		c.lru.MoveToFront(elem)
+		img := elem.Value.(*avatarEntry).img
+		c.mu.Unlock()
+		return img, nil
+	}
+	c.mu.Unlock()
+
+	if img, ok := c.loadFromDisk(handle); ok {
+		c.store(handle, img)
+		return img, nil
+	}
+	return c.do(ctx, avatarKey{handle: handle})
+}
+
+// Refresh fetches the image for handle with the SDK's refresh flag set, bypassing
+// both Discord's cache and this one, and replaces the cached copy.
+//
+// Returns the image or error if the context is cancelled, deadline exceeded, or the fetch fails.
+func (c *AvatarCache) Refresh(ctx context.Context, handle core.ImageHandle) (*image.RGBA, error) {
+	return c.do(ctx, avatarKey{handle: handle, refresh: true})
+}
+
+// Remove drops handle from memory and disk
+func (c *AvatarCache) Remove(handle core.ImageHandle) {
+	c.mu.Lock()
+	if elem, ok := c.entries[handle]; ok {
+		c.lru.Remove(elem)
+		delete(c.entries, handle)
+	}
+	c.mu.Unlock()
+	if c.dir != "" {
+		_ = os.Remove(c.diskPath(handle))
+	}
+}
+
+// Len returns the number of images held in memory
+func (c *AvatarCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// do joins or starts the fetch for key and waits for it, giving up when ctx is done.
+// The shared fetch is only cancelled once every caller waiting on it has given up.
+func (c *AvatarCache) do(ctx context.Context, key avatarKey) (*image.RGBA, error) {
+	c.mu.Lock()
+	call, ok := c.inflight[key]
+	if !ok || call.waiters == 0 { // a fetch everyone gave up on is already cancelled
+		fetchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &avatarCall{done: make(chan struct{}), cancel: cancel}
+		c.inflight[key] = call
+		go c.run(fetchCtx, key, call)
+	}
+	call.waiters++
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.img, call.err
+	case <-ctx.Done():
+		c.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			call.cancel()
+		}
+		c.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+func (c *AvatarCache) run(ctx context.Context, key avatarKey, call *avatarCall) {
+	defer call.cancel()
+	call.img, call.err = c.fetch(ctx, key.handle, key.refresh)
+	if call.err == nil {
+		c.store(key.handle, call.img)
+		c.saveToDisk(key.handle, call.img)
+	}
+
+	c.mu.Lock()
+	if c.inflight[key] == call {
+		delete(c.inflight, key)
+	}
+	c.mu.Unlock()
+	close(call.done)
+}
+
+// store adds or replaces handle in memory, evicting the least recently used images over capacity
+func (c *AvatarCache) store(handle core.ImageHandle, img *image.RGBA) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[handle]; ok {
+		elem.Value.(*avatarEntry).img = img
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[handle] = c.lru.PushFront(&avatarEntry{handle: handle, img: img})
+	for c.lru.Len() > c.capacity {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*avatarEntry).handle)
+	}
+}
+
+// loadFromDisk reads a persisted image. Missing or unreadable files are treated as a miss.
+func (c *AvatarCache) loadFromDisk(handle core.ImageHandle) (*image.RGBA, bool) {
+	if c.dir == "" {
+		return nil, false
+	}
+	data, err := os.ReadFile(c.diskPath(handle))
+	if err != nil {
+		return nil, false
+	}
+	decoded, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, false
+	}
+	if img, ok := decoded.(*image.RGBA); ok {
+		return img, true
+	}
+	img := image.NewRGBA(decoded.Bounds())
+	draw.Draw(img, img.Bounds(), decoded, decoded.Bounds().Min, draw.Src)
+	return img, true
+}
+
+// saveToDisk persists an image on a best-effort basis; the in-memory copy is authoritative
+func (c *AvatarCache) saveToDisk(handle core.ImageHandle, img *image.RGBA) {
+	if c.dir == "" {
+		return
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return
+	}
+	_ = writeFileAtomic(c.diskPath(handle), buf.Bytes())
+}
+
+func (c *AvatarCache) diskPath(handle core.ImageHandle) string {
+	return filepath.Join(c.dir, fmt.Sprintf("%d_%d_%d.png", handle.Type, handle.ID, handle.Size))
+}
@@ -0,0 +1,173 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleAvatarCache demonstrates how to cache avatars in memory and on disk.
+// This example is for documentation only and requires a real, initialized ImageClient.
+func ExampleAvatarCache() {
+	var imageClient *ImageClient // Assume this is properly initialized
+	var userID int64             // Assume this is a real user ID
+
+	avatars := NewAvatarCache(imageClient, AvatarCacheOptions{Capacity: 256, Dir: "avatars"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	handle := core.ImageHandle{Type: core.ImageTypeUser, ID: userID, Size: 64}
+	avatar, err := avatars.Get(ctx, handle)
+	if err != nil {
+		log.Fatalf("failed to get avatar: %v", err)
+	}
+	log.Printf("Avatar is %dx%d", avatar.Bounds().Dx(), avatar.Bounds().Dy())
+
+	// After the user changes their avatar, bypass both caches
+	if _, err := avatars.Refresh(ctx, handle); err != nil {
+		log.Fatalf("failed to refresh avatar: %v", err)
+	}
+	// No Output: (documentation only)
+}
+
+// fakeImages is a fetch function for AvatarCache that records every fetch. Fetches
+// block until release is closed when it is set.
+type fakeImages struct {
+	mu      sync.Mutex
+	fetches []string
+	release chan struct{}
+	err     error
+}
+
+func (f *fakeImages) fetch(ctx context.Context, handle core.ImageHandle, refresh bool) (*image.RGBA, error) {
+	f.mu.Lock()
+	f.fetches = append(f.fetches, fmt.Sprintf("%d refresh=%v", handle.ID, refresh))
+	release, err := f.release, f.err
+	f.mu.Unlock()
+	if release != nil {
+		select {
+		case <-release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	img := image.NewRGBA(image.Rect(0, 0, int(handle.Size), int(handle.Size)))
+	img.Pix[0], img.Pix[3] = uint8(handle.ID), 0xff
+	return img, nil
+}
+
+func (f *fakeImages) log() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.fetches...)
+}
+
+// waitForWaiters blocks until n callers wait on the fetch of handle
+func waitForWaiters(c *AvatarCache, handle core.ImageHandle, n int) {
+	for {
+		c.mu.Lock()
+		call := c.inflight[avatarKey{handle: handle}]
+		joined := call != nil && call.waiters == n
+		c.mu.Unlock()
+		if joined {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func avatar(id int64) core.ImageHandle {
+	return core.ImageHandle{Type: core.ImageTypeUser, ID: id, Size: 4}
+}
+
+// ExampleAvatarCache_eviction shows the least recently used image being evicted first.
+func ExampleAvatarCache_eviction() {
+	ctx := context.Background()
+	images := &fakeImages{}
+	avatars := newAvatarCache(images.fetch, AvatarCacheOptions{Capacity: 2})
+
+	for _, id := range []int64{1, 2, 1, 3, 1, 2} {
+		_, _ = avatars.Get(ctx, avatar(id))
+	}
+	fmt.Println(images.log(), avatars.Len())
+	// Output:
+	// [1 refresh=false 2 refresh=false 3 refresh=false 2 refresh=false] 2
+}
+
+// ExampleAvatarCache_sharedFetch shows concurrent requests for one image sharing a single
+// fetch, which keeps running when one of the callers gives up.
+func ExampleAvatarCache_sharedFetch() {
+	images := &fakeImages{release: make(chan struct{})}
+	avatars := newAvatarCache(images.fetch, AvatarCacheOptions{})
+
+	results := make(chan *image.RGBA, 2)
+	for range 2 {
+		go func() {
+			img, _ := avatars.Get(context.Background(), avatar(1))
+			results <- img
+		}()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := avatars.Get(ctx, avatar(1))
+		errs <- err
+	}()
+	waitForWaiters(avatars, avatar(1), 3)
+
+	cancel()
+	fmt.Println(<-errs)
+	close(images.release)
+	first, second := <-results, <-results
+	fmt.Println(first != nil && first == second, images.log())
+	// Output:
+	// context canceled
+	// true [1 refresh=false]
+}
+
+// ExampleAvatarCache_Refresh shows Refresh fetching again for an image that is cached,
+// and replacing the cached copy.
+func ExampleAvatarCache_Refresh() {
+	ctx := context.Background()
+	images := &fakeImages{}
+	avatars := newAvatarCache(images.fetch, AvatarCacheOptions{})
+
+	cached, _ := avatars.Get(ctx, avatar(1))
+	refreshed, _ := avatars.Refresh(ctx, avatar(1))
+	again, _ := avatars.Get(ctx, avatar(1))
+	fmt.Println(cached != refreshed, again == refreshed, images.log())
+	// Output:
+	// true true [1 refresh=false 1 refresh=true]
+}
+
+// ExampleAvatarCacheOptions_dir shows images persisted as PNG files being read back by
+// a new cache without fetching.
+func ExampleAvatarCacheOptions_dir() {
+	dir, err := os.MkdirTemp("", "avatars")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+
+	fetched, _ := newAvatarCache((&fakeImages{}).fetch, AvatarCacheOptions{Dir: dir}).Get(ctx, avatar(7))
+
+	offline := &fakeImages{err: errors.New("offline")}
+	loaded, err := newAvatarCache(offline.fetch, AvatarCacheOptions{Dir: dir}).Get(ctx, avatar(7))
+	fmt.Println(err, bytes.Equal(loaded.Pix, fetched.Pix), loaded.Bounds(), len(offline.log()))
+	// Output:
+	// <nil> true (0,0)-(4,4) 0
+}
@@ -0,0 +1,169 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// Catalog indexes SKUs by ID and by type.
+// A Catalog is immutable once built and safe for concurrent use.
+type Catalog struct {
+	skus   map[int64]core.Sku
+	byType map[core.SkuType][]core.Sku
+	order  []int64 // SKU IDs in ascending order
+}
+
+// NewCatalog builds a catalog from a list of SKUs. Later duplicates of the same
+// SKU ID replace earlier ones.
+func NewCatalog(skus []core.Sku) *Catalog {
+	c := &Catalog{
+		skus:   make(map[int64]core.Sku, len(skus)),
+		byType: make(map[core.SkuType][]core.Sku),
+	}
+	for _, sku := range skus {
+		c.skus[sku.ID] = sku
+	}
+	c.order = make([]int64, 0, len(c.skus))
+	for id := range c.skus {
+		c.order = append(c.order, id)
+	}
+	sort.Slice(c.order, func(i, j int) bool { return c.order[i] < c.order[j] })
+	for _, id := range c.order {
+		sku := c.skus[id]
+		c.byType[sku.Type] = append(c.byType[sku.Type], sku)
+	}
+	return c
+}
+
+// FetchCatalog fetches SKUs and indexes them in a Catalog, respecting context cancellation and timeout.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+//	defer cancel()
+//	catalog, err := client.Store().FetchCatalog(ctx)
+//	if err != nil {
+//	    log.Fatalf("failed to fetch catalog: %v", err)
+//	}
+//	for _, sku := range catalog.ByType(core.SkuTypeDLC) {
+//	    fmt.Printf("%s: %s\n", sku.Name, discord.FormatPrice(sku.Price))
+//	}
+//
+// Returns the catalog or error if the context is cancelled, deadline exceeded, or the fetch fails.
+func (sc *StoreClient) FetchCatalog(ctx context.Context) (*Catalog, error) {
+	skus, err := sc.FetchSkusWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewCatalog(skus), nil
+}
+
+// Len returns the number of SKUs in the catalog
+func (c *Catalog) Len() int {
+	return len(c.order)
+}
+
+// Get returns the SKU with the given ID
+func (c *Catalog) Get(skuID int64) (core.Sku, bool) {
+	sku, ok := c.skus[skuID]
+	return sku, ok
+}
+
+// All returns every SKU in the catalog ordered by ID
+func (c *Catalog) All() []core.Sku {
+	skus := make([]core.Sku, 0, len(c.order))
+	for _, id := range c.order {
+		skus = append(skus, c.skus[id])
+	}
+	return skus
+}
+
+// ByType returns the SKUs of the given type ordered by ID
+func (c *Catalog) ByType(skuType core.SkuType) []core.Sku {
+	return append([]core.Sku(nil), c.byType[skuType]...)
+}
+
+// SkuChange describes a SKU whose name, type or price differs between two catalogs
+type SkuChange struct {
+	Old core.Sku
+	New core.Sku
+}
+
+// CatalogDiff describes the differences between two catalogs
+type CatalogDiff struct {
+	Added   []core.Sku
+	Removed []core.Sku
+	Changed []SkuChange
+}
+
+// Empty reports whether the two catalogs were identical
+func (d CatalogDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff compares the catalog with a newer one, typically from a later fetch.
+// All slices in the result are ordered by SKU ID.
+func (c *Catalog) Diff(newer *Catalog) CatalogDiff {
+	var diff CatalogDiff
+	if newer == nil {
+		newer = NewCatalog(nil)
+	}
+	for _, id := range c.order {
+		old := c.skus[id]
+		sku, ok := newer.skus[id]
+		if !ok {
+			diff.Removed = append(diff.Removed, old)
+			continue
+		}
+		if sku != old {
+			diff.Changed = append(diff.Changed, SkuChange{Old: old, New: sku})
+		}
+	}
+	for _, id := range newer.order {
+		if _, ok := c.skus[id]; !ok {
+			diff.Added = append(diff.Added, newer.skus[id])
+		}
+	}
+	return diff
+}
+
+// currencyMinorUnits lists ISO 4217 currencies whose minor unit is not 2 digits
+var currencyMinorUnits = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// CurrencyMinorUnits returns the number of decimal digits used by the minor unit
+// of an ISO 4217 currency code, e.g. 2 for USD and 0 for JPY.
+// Unknown currencies are assumed to use 2 digits.
+func CurrencyMinorUnits(currency string) int {
+	if digits, ok := currencyMinorUnits[strings.ToUpper(currency)]; ok {
+		return digits
+	}
+	return 2
+}
+
+// FormatPrice formats a SKU price, whose amount is expressed in the currency's
+// minor unit, as a decimal amount followed by the currency code, e.g. "4.99 USD".
+func FormatPrice(price core.SkuPrice) string {
+	currency := strings.ToUpper(price.Currency)
+	digits := CurrencyMinorUnits(currency)
+
+	amount := fmt.Sprintf("%d", price.Amount)
+	if digits > 0 {
+		if len(amount) <= digits {
+			amount = strings.Repeat("0", digits-len(amount)+1) + amount
+		}
+		amount = amount[:len(amount)-digits] + "." + amount[len(amount)-digits:]
+	}
+	if currency == "" {
+		return amount
+	}
+	return amount + " " + currency
+}
@@ -0,0 +1,68 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleStoreClient_FetchCatalog demonstrates how to fetch the SKU catalog and list DLCs with their prices.
+// This example is for documentation only and requires a real, initialized StoreClient.
+func ExampleStoreClient_FetchCatalog() {
+	var storeClient *StoreClient // Assume this is properly initialized
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	catalog, err := storeClient.FetchCatalog(ctx)
+	if err != nil {
+		log.Fatalf("failed to fetch catalog: %v", err)
+	}
+	for _, sku := range catalog.ByType(core.SkuTypeDLC) {
+		log.Printf("%s: %s", sku.Name, FormatPrice(sku.Price))
+	}
+	// No Output: (documentation only)
+}
+
+// ExampleFormatPrice demonstrates how SKU prices are formatted using each currency's minor units.
+func ExampleFormatPrice() {
+	fmt.Println(FormatPrice(core.SkuPrice{Amount: 499, Currency: "usd"}))
+	fmt.Println(FormatPrice(core.SkuPrice{Amount: 5, Currency: "EUR"}))
+	fmt.Println(FormatPrice(core.SkuPrice{Amount: 1200, Currency: "JPY"}))
+	fmt.Println(FormatPrice(core.SkuPrice{Amount: 1500, Currency: "KWD"}))
+	// Output:
+	// 4.99 USD
+	// 0.05 EUR
+	// 1200 JPY
+	// 1.500 KWD
+}
+
+// ExampleCatalog_Diff demonstrates how to compare two catalogs fetched at different times.
+func ExampleCatalog_Diff() {
+	before := NewCatalog([]core.Sku{
+		{ID: 1, Type: core.SkuTypeDLC, Name: "Map Pack", Price: core.SkuPrice{Amount: 499, Currency: "USD"}},
+		{ID: 2, Type: core.SkuTypeConsumable, Name: "Coins", Price: core.SkuPrice{Amount: 99, Currency: "USD"}},
+	})
+	after := NewCatalog([]core.Sku{
+		{ID: 1, Type: core.SkuTypeDLC, Name: "Map Pack", Price: core.SkuPrice{Amount: 299, Currency: "USD"}},
+		{ID: 3, Type: core.SkuTypeDLC, Name: "Soundtrack", Price: core.SkuPrice{Amount: 999, Currency: "USD"}},
+	})
+
+	diff := before.Diff(after)
+	for _, sku := range diff.Added {
+		fmt.Println("added:", sku.Name)
+	}
+	for _, sku := range diff.Removed {
+		fmt.Println("removed:", sku.Name)
+	}
+	for _, change := range diff.Changed {
+		fmt.Printf("changed: %s %s -> %s\n", change.New.Name, FormatPrice(change.Old.Price), FormatPrice(change.New.Price))
+	}
+	// Output:
+	// added: Soundtrack
+	// removed: Coins
+	// changed: Map Pack 4.99 USD -> 2.99 USD
+}
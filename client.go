@@ -3,6 +3,7 @@ package discord
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	core "github.com/andresperezl/discordgamesdk-go/core"
@@ -15,6 +16,9 @@ type Client struct {
 	initialized bool
 	ctx         context.Context
 	cancel      context.CancelFunc
+
+	overlayOnce sync.Once
+	overlay     *overlayTracker
 }
 
 // ClientConfig holds configuration for creating a Discord client
@@ -72,6 +76,9 @@ func (c *Client) Close() error {
 	if c.cancel != nil {
 		c.cancel()
 	}
+	if c.overlay != nil {
+		c.overlay.close()
+	}
 	if c.core != nil {
 		c.core.Shutdown()
 	}
@@ -141,10 +148,11 @@ func (c *Client) Application() *ApplicationClient {
 
 // Storage returns a storage manager with Go-like methods
 func (c *Client) Storage() *StorageClient {
-	return &StorageClient{
-		manager: c.core.GetStorageManager(),
-		core:    c.core,
+	sc := &StorageClient{core: c.core}
+	if manager := c.core.GetStorageManager(); manager != nil {
+		sc.manager = manager // a nil *core.StorageManager must leave the interface nil
 	}
+	return sc
 }
 
 // Lobby returns a lobby manager with Go-like methods
@@ -165,9 +173,17 @@ func (c *Client) Network() *NetworkClient {
 
 // Overlay returns an overlay manager with Go-like methods
 func (c *Client) Overlay() *OverlayClient {
+	manager := c.core.GetOverlayManager()
+	c.overlayOnce.Do(func() {
+		// Subscribe before seeding so a toggle during the read is not lost
+		c.overlay = &overlayTracker{}
+		c.overlay.listen(c.core)
+		c.overlay.seed(manager)
+	})
 	return &OverlayClient{
-		manager: c.core.GetOverlayManager(),
+		manager: manager,
 		core:    c.core,
+		state:   c.overlay,
 	}
 }
 
@@ -195,6 +211,23 @@ func (c *Client) Achievement() *AchievementClient {
 	}
 }
 
+// Image returns an image manager with Go-like methods
+func (c *Client) Image() *ImageClient {
+	return &ImageClient{
+		manager: c.core.GetImageManager(),
+		core:    c.core,
+	}
+}
+
+// Relationship returns a relationship manager with Go-like methods
+func (c *Client) Relationship() *RelationshipClient {
+	return &RelationshipClient{
+		manager:       c.core.GetRelationshipManager(),
+		core:          c.core,
+		applicationID: c.clientID,
+	}
+}
+
 // Run starts the client's event loop
 func (c *Client) Run() {
 	// Run until context is cancelled
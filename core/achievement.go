@@ -6,13 +6,49 @@ import (
 	dcgo "github.com/andresperezl/discordgamesdk-go/discordcgo"
 )
 
-// SetUserAchievement sets a user achievement
+// cDiscordUserAchievement mirrors the memory layout of the C DiscordUserAchievement struct
+type cDiscordUserAchievement struct {
+	UserID          int64
+	AchievementID   int64
+	PercentComplete uint8
+	UnlockedAt      [64]byte
+}
+
+func userAchievementFromC(c *cDiscordUserAchievement) *UserAchievement {
+	return &UserAchievement{
+		UserID:          c.UserID,
+		AchievementID:   c.AchievementID,
+		PercentComplete: c.PercentComplete,
+		UnlockedAt:      cString(c.UnlockedAt[:]),
+	}
+}
+
+// SetUserAchievement sets a user achievement without waiting for the result, so it
+// always returns ResultOk unless the manager is missing
+//
+// Deprecated: use SetUserAchievementAsync, which reports the result.
 func (a *AchievementManager) SetUserAchievement(achievementID int64, percentComplete uint8) Result {
 	if a.manager == nil {
 		return ResultInternalError
 	}
 	dcgo.AchievementManagerSetUserAchievement(a.manager, achievementID, percentComplete, nil, nil)
-	return ResultOk // TODO: callback support
+	return ResultOk
+}
+
+// SetUserAchievementAsync sets the current user's progress on an achievement and
+// calls callback with the result once Discord has recorded it
+func (a *AchievementManager) SetUserAchievementAsync(achievementID int64, percentComplete uint8, callback func(result Result)) {
+	if a.manager == nil {
+		if callback != nil {
+			callback(ResultInternalError)
+		}
+		return
+	}
+	dcgo.AchievementManagerSetUserAchievementGo(a.manager, achievementID, percentComplete, func(result int32) {
+		if callback != nil {
+			callback(Result(result))
+		}
+	})
 }
 
 // GetUserAchievement gets a user achievement
@@ -20,22 +56,12 @@ func (a *AchievementManager) GetUserAchievement(userAchievementID int64) (*UserA
 	if a.manager == nil {
 		return nil, ResultInternalError
 	}
-	var cAch struct {
-		UserID          int64
-		AchievementID   int64
-		PercentComplete uint8
-		UnlockedAt      [64]byte
-	}
+	var cAch cDiscordUserAchievement
 	res := dcgo.AchievementManagerGetUserAchievement(a.manager, userAchievementID, unsafe.Pointer(&cAch))
 	if res != 0 {
 		return nil, Result(res)
 	}
-	return &UserAchievement{
-		UserID:          cAch.UserID,
-		AchievementID:   cAch.AchievementID,
-		PercentComplete: cAch.PercentComplete,
-		UnlockedAt:      string(cAch.UnlockedAt[:]),
-	}, ResultOk
+	return userAchievementFromC(&cAch), ResultOk
 }
 
 // GetUserAchievementAt gets a user achievement at index
@@ -43,22 +69,12 @@ func (a *AchievementManager) GetUserAchievementAt(index int32) (*UserAchievement
 	if a.manager == nil {
 		return nil, ResultInternalError
 	}
-	var cAch struct {
-		UserID          int64
-		AchievementID   int64
-		PercentComplete uint8
-		UnlockedAt      [64]byte
-	}
+	var cAch cDiscordUserAchievement
 	res := dcgo.AchievementManagerGetUserAchievementAt(a.manager, index, unsafe.Pointer(&cAch))
 	if res != 0 {
 		return nil, Result(res)
 	}
-	return &UserAchievement{
-		UserID:          cAch.UserID,
-		AchievementID:   cAch.AchievementID,
-		PercentComplete: cAch.PercentComplete,
-		UnlockedAt:      string(cAch.UnlockedAt[:]),
-	}, ResultOk
+	return userAchievementFromC(&cAch), ResultOk
 }
 
 // GetUserAchievementCount gets the number of user achievements
@@ -78,3 +94,19 @@ func (a *AchievementManager) FetchUserAchievements(callbackData unsafe.Pointer,
 	}
 	dcgo.AchievementManagerFetchUserAchievements(a.manager, callbackData, callback)
 }
+
+// FetchUserAchievementsAsync loads the current user's achievements and calls callback
+// with the result, after which GetUserAchievementCount and GetUserAchievementAt can be used
+func (a *AchievementManager) FetchUserAchievementsAsync(callback func(result Result)) {
+	if a.manager == nil {
+		if callback != nil {
+			callback(ResultInternalError)
+		}
+		return
+	}
+	dcgo.AchievementManagerFetchUserAchievementsGo(a.manager, func(result int32) {
+		if callback != nil {
+			callback(Result(result))
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package core
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/andresperezl/discordgamesdk-go/internal/sdklayout"
+)
+
+func TestUserAchievementMirrorLayout(t *testing.T) {
+	var achievement cDiscordUserAchievement
+	checkLayout(t, "DiscordUserAchievement", sdklayout.Struct{
+		Size: unsafe.Sizeof(achievement),
+		Offsets: map[string]uintptr{
+			"user_id":          unsafe.Offsetof(achievement.UserID),
+			"achievement_id":   unsafe.Offsetof(achievement.AchievementID),
+			"percent_complete": unsafe.Offsetof(achievement.PercentComplete),
+			"unlocked_at":      unsafe.Offsetof(achievement.UnlockedAt),
+		},
+	})
+}
+
+func TestUserAchievementFromC(t *testing.T) {
+	c := cDiscordUserAchievement{UserID: 11, AchievementID: 22, PercentComplete: 100}
+	copy(c.UnlockedAt[:], "2024-01-01T12:00:00Z\x00garbage")
+	want := UserAchievement{UserID: 11, AchievementID: 22, PercentComplete: 100, UnlockedAt: "2024-01-01T12:00:00Z"}
+	if got := userAchievementFromC(&c); *got != want {
+		t.Errorf("userAchievementFromC = %+v, want %+v", *got, want)
+	}
+}
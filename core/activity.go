@@ -67,60 +67,7 @@ func (a *ActivityManager) UpdateActivity(activity *Activity, callback func(resul
 	}
 
 	// Convert Go Activity to C struct
-	var cActivity struct {
-		Type          int32
-		ApplicationID int64
-		Name          [128]byte
-		State         [128]byte
-		Details       [128]byte
-		Timestamps    struct {
-			Start int64
-			End   int64
-		}
-		Assets struct {
-			LargeImage [128]byte
-			LargeText  [128]byte
-			SmallImage [128]byte
-			SmallText  [128]byte
-		}
-		Party struct {
-			ID   [128]byte
-			Size struct {
-				CurrentSize int32
-				MaxSize     int32
-			}
-			Privacy int32
-		}
-		Secrets struct {
-			Match    [128]byte
-			Join     [128]byte
-			Spectate [128]byte
-		}
-		Instance           bool
-		SupportedPlatforms uint32
-	}
-
-	// Copy data from Go struct to C struct
-	cActivity.Type = int32(activity.Type)
-	cActivity.ApplicationID = activity.ApplicationID
-	copy(cActivity.Name[:], activity.Name)
-	copy(cActivity.State[:], activity.State)
-	copy(cActivity.Details[:], activity.Details)
-	cActivity.Timestamps.Start = activity.Timestamps.Start
-	cActivity.Timestamps.End = activity.Timestamps.End
-	copy(cActivity.Assets.LargeImage[:], activity.Assets.LargeImage)
-	copy(cActivity.Assets.LargeText[:], activity.Assets.LargeText)
-	copy(cActivity.Assets.SmallImage[:], activity.Assets.SmallImage)
-	copy(cActivity.Assets.SmallText[:], activity.Assets.SmallText)
-	copy(cActivity.Party.ID[:], activity.Party.ID)
-	cActivity.Party.Size.CurrentSize = activity.Party.Size.CurrentSize
-	cActivity.Party.Size.MaxSize = activity.Party.Size.MaxSize
-	cActivity.Party.Privacy = int32(activity.Party.Privacy)
-	copy(cActivity.Secrets.Match[:], activity.Secrets.Match)
-	copy(cActivity.Secrets.Join[:], activity.Secrets.Join)
-	copy(cActivity.Secrets.Spectate[:], activity.Secrets.Spectate)
-	cActivity.Instance = activity.Instance
-	cActivity.SupportedPlatforms = activity.SupportedPlatforms
+	cActivity := activityToC(activity)
 
 	// Call the C wrapper function
 	dcgo.RunOnDispatcherSync(func() any {
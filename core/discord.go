@@ -231,7 +231,7 @@ type Core struct {
 	callbackID      int64
 	callbackIDMutex sync.Mutex
 
-	coreEvents *CoreEvents // Store reference to CoreEvents for event handler updates
+	events coreEventSets // Subscribers of the SDK events raised while RunCallbacks runs
 }
 
 // Start begins a background goroutine that continuously calls RunCallbacks.
@@ -372,7 +372,9 @@ func (c *Core) GenerateCallbackID() string {
 // Create creates a new Discord SDK instance
 func Create(clientID int64, flags CreateFlags, events *CoreEvents) (*Core, Result) {
 	discordlog.GetLogger().Info("Core.Create called", "clientID", clientID, "flags", flags)
-	core, result := dcgo.CoreCreateHelper(clientID, uint64(flags))
+	c := &Core{}
+	c.events.install(events)
+	core, result := dcgo.CoreCreateWithEvents(clientID, uint64(flags), c.events.handlers())
 
 	if result != 0 {
 		discordlog.GetLogger().Error("Core.Create failed", "result", result)
@@ -380,7 +382,8 @@ func Create(clientID int64, flags CreateFlags, events *CoreEvents) (*Core, Resul
 	}
 	discordlog.GetLogger().Info("Core.Create succeeded")
 
-	return &Core{ptr: core}, ResultOk
+	c.ptr = core
+	return c, ResultOk
 }
 
 // Destroy destroys the Discord SDK instance
@@ -585,6 +588,21 @@ func (im *ImageManager) Fetch(handle ImageHandle, refresh bool, callbackData uns
 	dcgo.ImageManagerFetch(im.manager, unsafe.Pointer(&handle), refresh, callbackData, callback)
 }
 
+// FetchAsync fetches an image and calls callback with the result and the fetched handle
+func (im *ImageManager) FetchAsync(handle ImageHandle, refresh bool, callback func(result Result, handle ImageHandle)) {
+	if im.manager == nil {
+		if callback != nil {
+			callback(ResultInternalError, handle)
+		}
+		return
+	}
+	dcgo.ImageManagerFetchGo(im.manager, unsafe.Pointer(&handle), refresh, func(result int32, handleResult unsafe.Pointer) {
+		if callback != nil {
+			callback(Result(result), *(*ImageHandle)(handleResult))
+		}
+	})
+}
+
 // GetDimensions retrieves the dimensions of an image
 func (im *ImageManager) GetDimensions(handle ImageHandle) (ImageDimensions, Result) {
 	var dims ImageDimensions
@@ -747,6 +765,9 @@ func convertDiscordEntitlement(ent *dcgo.DiscordEntitlement) *Entitlement {
 
 // Filter filters relationships using a callback function
 func (rm *RelationshipManager) Filter(filterData unsafe.Pointer, filter unsafe.Pointer) {
+	relationshipFilters.Store(rm.manager, func() {
+		dcgo.RelationshipManagerFilter(rm.manager, filterData, filter)
+	})
 	dcgo.RelationshipManagerFilter(rm.manager, filterData, filter)
 }
 
@@ -759,36 +780,134 @@ func (rm *RelationshipManager) Count() (int32, Result) {
 
 // Get retrieves a relationship by user ID
 func (rm *RelationshipManager) Get(userID int64) (*Relationship, Result) {
-	var rel Relationship
+	if rm.manager == nil {
+		return nil, ResultInternalError
+	}
+	var rel cDiscordRelationship
 	res := dcgo.RelationshipManagerGet(rm.manager, userID, unsafe.Pointer(&rel))
 	if res != 0 {
 		return nil, Result(res)
 	}
-	return &rel, ResultOk
+	return relationshipFromC(&rel), ResultOk
 }
 
 // GetAt retrieves a relationship by index
 func (rm *RelationshipManager) GetAt(index uint32) (*Relationship, Result) {
-	var rel Relationship
+	if rm.manager == nil {
+		return nil, ResultInternalError
+	}
+	var rel cDiscordRelationship
 	res := dcgo.RelationshipManagerGetAt(rm.manager, index, unsafe.Pointer(&rel))
 	if res != 0 {
 		return nil, Result(res)
 	}
-	return &rel, ResultOk
+	return relationshipFromC(&rel), ResultOk
+}
+
+// SetUserEvents sets or updates the UserEvents handler at runtime.
+// Handlers added with AddUserEvents are not affected.
+func (c *Core) SetUserEvents(events *UserEvents) {
+	c.events.user.replace(events)
+}
+
+// AddUserEvents subscribes events alongside the other UserEvents handlers and
+// returns a function that removes only this subscription.
+func (c *Core) AddUserEvents(events *UserEvents) func() {
+	return c.events.user.add(events)
 }
 
 // SetActivityEvents sets or updates the ActivityEvents handler at runtime.
+// Handlers added with AddActivityEvents are not affected.
 func (c *Core) SetActivityEvents(events *ActivityEvents) {
-	if c.coreEvents == nil {
-		c.coreEvents = NewCoreEvents()
-	}
-	c.coreEvents.SetActivityEvents(events)
+	c.events.activity.replace(events)
+}
+
+// AddActivityEvents subscribes events alongside the other ActivityEvents handlers and
+// returns a function that removes only this subscription.
+func (c *Core) AddActivityEvents(events *ActivityEvents) func() {
+	return c.events.activity.add(events)
 }
 
 // SetLobbyEvents sets or updates the LobbyEvents handler at runtime.
+// Handlers added with AddLobbyEvents are not affected.
 func (c *Core) SetLobbyEvents(events *LobbyEvents) {
-	if c.coreEvents == nil {
-		c.coreEvents = NewCoreEvents()
-	}
-	c.coreEvents.SetLobbyEvents(events)
+	c.events.lobby.replace(events)
+}
+
+// AddLobbyEvents subscribes events alongside the other LobbyEvents handlers and
+// returns a function that removes only this subscription.
+func (c *Core) AddLobbyEvents(events *LobbyEvents) func() {
+	return c.events.lobby.add(events)
+}
+
+// SetNetworkEvents sets or updates the NetworkEvents handler at runtime.
+// Handlers added with AddNetworkEvents are not affected.
+func (c *Core) SetNetworkEvents(events *NetworkEvents) {
+	c.events.network.replace(events)
+}
+
+// AddNetworkEvents subscribes events alongside the other NetworkEvents handlers and
+// returns a function that removes only this subscription.
+func (c *Core) AddNetworkEvents(events *NetworkEvents) func() {
+	return c.events.network.add(events)
+}
+
+// SetStoreEvents sets or updates the StoreEvents handler at runtime.
+// Handlers added with AddStoreEvents are not affected.
+func (c *Core) SetStoreEvents(events *StoreEvents) {
+	c.events.store.replace(events)
+}
+
+// AddStoreEvents subscribes events alongside the other StoreEvents handlers and
+// returns a function that removes only this subscription.
+func (c *Core) AddStoreEvents(events *StoreEvents) func() {
+	return c.events.store.add(events)
+}
+
+// SetRelationshipEvents sets or updates the RelationshipEvents handler at runtime.
+// Handlers added with AddRelationshipEvents are not affected.
+func (c *Core) SetRelationshipEvents(events *RelationshipEvents) {
+	c.events.relationship.replace(events)
+}
+
+// AddRelationshipEvents subscribes events alongside the other RelationshipEvents handlers and
+// returns a function that removes only this subscription.
+func (c *Core) AddRelationshipEvents(events *RelationshipEvents) func() {
+	return c.events.relationship.add(events)
+}
+
+// SetVoiceEvents sets or updates the VoiceEvents handler at runtime.
+// Handlers added with AddVoiceEvents are not affected.
+func (c *Core) SetVoiceEvents(events *VoiceEvents) {
+	c.events.voice.replace(events)
+}
+
+// AddVoiceEvents subscribes events alongside the other VoiceEvents handlers and
+// returns a function that removes only this subscription.
+func (c *Core) AddVoiceEvents(events *VoiceEvents) func() {
+	return c.events.voice.add(events)
+}
+
+// SetOverlayEvents sets or updates the OverlayEvents handler at runtime.
+// Handlers added with AddOverlayEvents are not affected.
+func (c *Core) SetOverlayEvents(events *OverlayEvents) {
+	c.events.overlay.replace(events)
+}
+
+// AddOverlayEvents subscribes events alongside the other OverlayEvents handlers and
+// returns a function that removes only this subscription.
+func (c *Core) AddOverlayEvents(events *OverlayEvents) func() {
+	return c.events.overlay.add(events)
+}
+
+// SetAchievementEvents sets or updates the AchievementEvents handler at runtime.
+// Handlers added with AddAchievementEvents are not affected.
+func (c *Core) SetAchievementEvents(events *AchievementEvents) {
+	c.events.achievement.replace(events)
+}
+
+// AddAchievementEvents subscribes events alongside the other AchievementEvents handlers and
+// returns a function that removes only this subscription.
+func (c *Core) AddAchievementEvents(events *AchievementEvents) func() {
+	return c.events.achievement.add(events)
 }
@@ -1,6 +1,11 @@
 package core
 
-import "unsafe"
+import (
+	"sync"
+	"unsafe"
+
+	dcgo "github.com/andresperezl/discordgamesdk-go/discordcgo"
+)
 
 // CoreEvents contains all event callbacks for the Discord SDK
 type CoreEvents struct {
@@ -146,8 +151,7 @@ func (e *CoreEvents) SetUserEvents(events *UserEvents) {
 		return
 	}
 
-	// NOTE: C structure creation not supported in wrapper approach
-	// Just store the events pointer for now
+	// Create installs the stored handlers when the core is created
 	e.userEvents = unsafe.Pointer(events)
 	e.eventData = unsafe.Pointer(events)
 }
@@ -159,8 +163,7 @@ func (e *CoreEvents) SetImageEvents(events *ImageEvents) {
 		return
 	}
 
-	// NOTE: C structure creation not supported in wrapper approach
-	// Just store the events pointer for now
+	// Create installs the stored handlers when the core is created
 	e.imageEvents = unsafe.Pointer(events)
 }
 
@@ -171,8 +174,7 @@ func (e *CoreEvents) SetActivityEvents(events *ActivityEvents) {
 		return
 	}
 
-	// NOTE: C structure creation not supported in wrapper approach
-	// Just store the events pointer for now
+	// Create installs the stored handlers when the core is created
 	e.activityEvents = unsafe.Pointer(events)
 }
 
@@ -183,8 +185,7 @@ func (e *CoreEvents) SetRelationshipEvents(events *RelationshipEvents) {
 		return
 	}
 
-	// NOTE: C structure creation not supported in wrapper approach
-	// Just store the events pointer for now
+	// Create installs the stored handlers when the core is created
 	e.relationshipEvents = unsafe.Pointer(events)
 }
 
@@ -195,8 +196,7 @@ func (e *CoreEvents) SetLobbyEvents(events *LobbyEvents) {
 		return
 	}
 
-	// NOTE: C structure creation not supported in wrapper approach
-	// Just store the events pointer for now
+	// Create installs the stored handlers when the core is created
 	e.lobbyEvents = unsafe.Pointer(events)
 }
 
@@ -207,8 +207,7 @@ func (e *CoreEvents) SetNetworkEvents(events *NetworkEvents) {
 		return
 	}
 
-	// NOTE: C structure creation not supported in wrapper approach
-	// Just store the events pointer for now
+	// Create installs the stored handlers when the core is created
 	e.networkEvents = unsafe.Pointer(events)
 }
 
@@ -219,8 +218,7 @@ func (e *CoreEvents) SetOverlayEvents(events *OverlayEvents) {
 		return
 	}
 
-	// NOTE: C structure creation not supported in wrapper approach
-	// Just store the events pointer for now
+	// Create installs the stored handlers when the core is created
 	e.overlayEvents = unsafe.Pointer(events)
 }
 
@@ -231,8 +229,7 @@ func (e *CoreEvents) SetStorageEvents(events *StorageEvents) {
 		return
 	}
 
-	// NOTE: C structure creation not supported in wrapper approach
-	// Just store the events pointer for now
+	// Create installs the stored handlers when the core is created
 	e.storageEvents = unsafe.Pointer(events)
 }
 
@@ -243,8 +240,7 @@ func (e *CoreEvents) SetStoreEvents(events *StoreEvents) {
 		return
 	}
 
-	// NOTE: C structure creation not supported in wrapper approach
-	// Just store the events pointer for now
+	// Create installs the stored handlers when the core is created
 	e.storeEvents = unsafe.Pointer(events)
 }
 
@@ -255,8 +251,7 @@ func (e *CoreEvents) SetVoiceEvents(events *VoiceEvents) {
 		return
 	}
 
-	// NOTE: C structure creation not supported in wrapper approach
-	// Just store the events pointer for now
+	// Create installs the stored handlers when the core is created
 	e.voiceEvents = unsafe.Pointer(events)
 }
 
@@ -267,7 +262,272 @@ func (e *CoreEvents) SetAchievementEvents(events *AchievementEvents) {
 		return
 	}
 
-	// NOTE: C structure creation not supported in wrapper approach
-	// Just store the events pointer for now
+	// Create installs the stored handlers when the core is created
 	e.achievementEvents = unsafe.Pointer(events)
 }
+
+// eventSet holds the handlers of one kind of SDK event. The handler installed with a
+// Core.SetXxxEvents call is kept apart from those added with Core.AddXxxEvents, so
+// replacing it leaves every other subscriber in place.
+type eventSet[T any] struct {
+	mu    sync.RWMutex
+	set   *T
+	added []eventEntry[T]
+	next  uint64
+}
+
+type eventEntry[T any] struct {
+	id     uint64
+	events *T
+}
+
+// replace swaps the handler installed with SetXxxEvents; nil removes it
+func (s *eventSet[T]) replace(events *T) {
+	s.mu.Lock()
+	s.set = events
+	s.mu.Unlock()
+}
+
+// add subscribes events and returns a function that removes exactly this subscription
+func (s *eventSet[T]) add(events *T) func() {
+	if events == nil {
+		return func() {}
+	}
+	s.mu.Lock()
+	s.next++
+	id := s.next
+	s.added = append(s.added, eventEntry[T]{id: id, events: events})
+	s.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			for i, entry := range s.added {
+				if entry.id == id {
+					s.added = append(s.added[:i:i], s.added[i+1:]...)
+					return
+				}
+			}
+		})
+	}
+}
+
+// each calls fn for every handler, the SetXxxEvents one first. Handlers may
+// subscribe or unsubscribe from within fn.
+func (s *eventSet[T]) each(fn func(events *T)) {
+	s.mu.RLock()
+	set := s.set
+	added := s.added
+	s.mu.RUnlock()
+	if set != nil {
+		fn(set)
+	}
+	for _, entry := range added {
+		fn(entry.events)
+	}
+}
+
+// coreEventSets multiplexes the SDK events of one Core to its subscribers
+type coreEventSets struct {
+	user         eventSet[UserEvents]
+	activity     eventSet[ActivityEvents]
+	relationship eventSet[RelationshipEvents]
+	lobby        eventSet[LobbyEvents]
+	network      eventSet[NetworkEvents]
+	overlay      eventSet[OverlayEvents]
+	store        eventSet[StoreEvents]
+	voice        eventSet[VoiceEvents]
+	achievement  eventSet[AchievementEvents]
+}
+
+// install replaces the SetXxxEvents handlers with those stored in events
+func (s *coreEventSets) install(events *CoreEvents) {
+	if events == nil {
+		return
+	}
+	s.user.replace((*UserEvents)(events.userEvents))
+	s.activity.replace((*ActivityEvents)(events.activityEvents))
+	s.relationship.replace((*RelationshipEvents)(events.relationshipEvents))
+	s.lobby.replace((*LobbyEvents)(events.lobbyEvents))
+	s.network.replace((*NetworkEvents)(events.networkEvents))
+	s.overlay.replace((*OverlayEvents)(events.overlayEvents))
+	s.store.replace((*StoreEvents)(events.storeEvents))
+	s.voice.replace((*VoiceEvents)(events.voiceEvents))
+	s.achievement.replace((*AchievementEvents)(events.achievementEvents))
+}
+
+// handlers returns the table the SDK events are delivered to. C structs are converted
+// once per event and every subscriber receives its own copy.
+func (s *coreEventSets) handlers() *dcgo.EventHandlers {
+	return &dcgo.EventHandlers{
+		OnCurrentUserUpdate: func() {
+			s.user.each(func(e *UserEvents) {
+				if e.OnCurrentUserUpdate != nil {
+					e.OnCurrentUserUpdate()
+				}
+			})
+		},
+		OnActivityJoin: func(secret string) {
+			s.activity.each(func(e *ActivityEvents) {
+				if e.OnActivityJoin != nil {
+					e.OnActivityJoin(secret)
+				}
+			})
+		},
+		OnActivitySpectate: func(secret string) {
+			s.activity.each(func(e *ActivityEvents) {
+				if e.OnActivitySpectate != nil {
+					e.OnActivitySpectate(secret)
+				}
+			})
+		},
+		OnActivityJoinRequest: func(user unsafe.Pointer) {
+			u := userFromC((*cDiscordUser)(user))
+			s.activity.each(func(e *ActivityEvents) {
+				if e.OnActivityJoinRequest != nil {
+					u := u
+					e.OnActivityJoinRequest(&u)
+				}
+			})
+		},
+		OnActivityInvite: func(actionType int32, user unsafe.Pointer, activity unsafe.Pointer) {
+			u := userFromC((*cDiscordUser)(user))
+			a := activityFromC((*cDiscordActivity)(activity))
+			s.activity.each(func(e *ActivityEvents) {
+				if e.OnActivityInvite != nil {
+					u, a := u, a
+					e.OnActivityInvite(ActivityActionType(actionType), &u, &a)
+				}
+			})
+		},
+		OnRelationshipRefresh: func() {
+			s.relationship.each(func(e *RelationshipEvents) {
+				if e.OnRefresh != nil {
+					e.OnRefresh()
+				}
+			})
+		},
+		OnRelationshipUpdate: func(relationship unsafe.Pointer) {
+			rel := *relationshipFromC((*cDiscordRelationship)(relationship))
+			s.relationship.each(func(e *RelationshipEvents) {
+				if e.OnRelationshipUpdate != nil {
+					rel := rel
+					e.OnRelationshipUpdate(&rel)
+				}
+			})
+		},
+		OnLobbyUpdate: func(lobbyID int64) {
+			s.lobby.each(func(e *LobbyEvents) {
+				if e.OnLobbyUpdate != nil {
+					e.OnLobbyUpdate(lobbyID)
+				}
+			})
+		},
+		OnLobbyDelete: func(lobbyID int64, reason uint32) {
+			s.lobby.each(func(e *LobbyEvents) {
+				if e.OnLobbyDelete != nil {
+					e.OnLobbyDelete(lobbyID, reason)
+				}
+			})
+		},
+		OnMemberConnect: func(lobbyID int64, userID int64) {
+			s.lobby.each(func(e *LobbyEvents) {
+				if e.OnMemberConnect != nil {
+					e.OnMemberConnect(lobbyID, userID)
+				}
+			})
+		},
+		OnMemberUpdate: func(lobbyID int64, userID int64) {
+			s.lobby.each(func(e *LobbyEvents) {
+				if e.OnMemberUpdate != nil {
+					e.OnMemberUpdate(lobbyID, userID)
+				}
+			})
+		},
+		OnMemberDisconnect: func(lobbyID int64, userID int64) {
+			s.lobby.each(func(e *LobbyEvents) {
+				if e.OnMemberDisconnect != nil {
+					e.OnMemberDisconnect(lobbyID, userID)
+				}
+			})
+		},
+		OnLobbyMessage: func(lobbyID int64, userID int64, data []byte) {
+			s.lobby.each(func(e *LobbyEvents) {
+				if e.OnLobbyMessage != nil {
+					e.OnLobbyMessage(lobbyID, userID, append([]byte(nil), data...))
+				}
+			})
+		},
+		OnSpeaking: func(lobbyID int64, userID int64, speaking bool) {
+			s.lobby.each(func(e *LobbyEvents) {
+				if e.OnSpeaking != nil {
+					e.OnSpeaking(lobbyID, userID, speaking)
+				}
+			})
+		},
+		OnLobbyNetworkMessage: func(lobbyID int64, userID int64, channelID uint8, data []byte) {
+			s.lobby.each(func(e *LobbyEvents) {
+				if e.OnNetworkMessage != nil {
+					e.OnNetworkMessage(lobbyID, userID, channelID, append([]byte(nil), data...))
+				}
+			})
+		},
+		OnNetworkMessage: func(peerID uint64, channelID uint8, data []byte) {
+			s.network.each(func(e *NetworkEvents) {
+				if e.OnMessage != nil {
+					e.OnMessage(peerID, channelID, append([]byte(nil), data...))
+				}
+			})
+		},
+		OnRouteUpdate: func(routeData string) {
+			s.network.each(func(e *NetworkEvents) {
+				if e.OnRouteUpdate != nil {
+					e.OnRouteUpdate(routeData)
+				}
+			})
+		},
+		OnOverlayToggle: func(locked bool) {
+			s.overlay.each(func(e *OverlayEvents) {
+				if e.OnToggle != nil {
+					e.OnToggle(locked)
+				}
+			})
+		},
+		OnEntitlementCreate: func(entitlement unsafe.Pointer) {
+			ent := *convertDiscordEntitlement(dcgo.GetDiscordEntitlement(entitlement))
+			s.store.each(func(e *StoreEvents) {
+				if e.OnEntitlementCreate != nil {
+					ent := ent
+					e.OnEntitlementCreate(&ent)
+				}
+			})
+		},
+		OnEntitlementDelete: func(entitlement unsafe.Pointer) {
+			ent := *convertDiscordEntitlement(dcgo.GetDiscordEntitlement(entitlement))
+			s.store.each(func(e *StoreEvents) {
+				if e.OnEntitlementDelete != nil {
+					ent := ent
+					e.OnEntitlementDelete(&ent)
+				}
+			})
+		},
+		OnVoiceSettingsUpdate: func() {
+			s.voice.each(func(e *VoiceEvents) {
+				if e.OnSettingsUpdate != nil {
+					e.OnSettingsUpdate()
+				}
+			})
+		},
+		OnUserAchievementUpdate: func(userAchievement unsafe.Pointer) {
+			ach := *userAchievementFromC((*cDiscordUserAchievement)(userAchievement))
+			s.achievement.each(func(e *AchievementEvents) {
+				if e.OnUserAchievementUpdate != nil {
+					ach := ach
+					e.OnUserAchievementUpdate(&ach)
+				}
+			})
+		},
+	}
+}
@@ -0,0 +1,110 @@
+package core
+
+import (
+	"testing"
+	"unsafe"
+
+	dcgo "github.com/andresperezl/discordgamesdk-go/discordcgo"
+)
+
+// eventData registers the handlers of c the way Create does and returns the
+// event_data the SDK passes back with every event
+func eventData(t *testing.T, c *Core) unsafe.Pointer {
+	data, free := dcgo.NewEventData(c.events.handlers())
+	t.Cleanup(free)
+	return data
+}
+
+func TestEventSubscribers(t *testing.T) {
+	c := &Core{}
+	data := eventData(t, c)
+
+	var set, first, second int
+	c.SetVoiceEvents(&VoiceEvents{OnSettingsUpdate: func() { set++ }})
+	removeFirst := c.AddVoiceEvents(&VoiceEvents{OnSettingsUpdate: func() { first++ }})
+	c.AddVoiceEvents(&VoiceEvents{OnSettingsUpdate: func() { second++ }})
+
+	dcgo.CoreOnVoiceSettingsUpdate(data)
+	if set != 1 || first != 1 || second != 1 {
+		t.Fatalf("after first event: set=%d first=%d second=%d, want 1 1 1", set, first, second)
+	}
+
+	removeFirst()
+	removeFirst()
+	dcgo.CoreOnVoiceSettingsUpdate(data)
+	if set != 2 || first != 1 || second != 2 {
+		t.Fatalf("after removing first: set=%d first=%d second=%d, want 2 1 2", set, first, second)
+	}
+
+	c.SetVoiceEvents(nil)
+	dcgo.CoreOnVoiceSettingsUpdate(data)
+	if set != 2 || second != 3 {
+		t.Fatalf("after clearing the set handler: set=%d second=%d, want 2 3", set, second)
+	}
+}
+
+func TestEventUnsubscribeDuringDispatch(t *testing.T) {
+	c := &Core{}
+	data := eventData(t, c)
+
+	var calls []bool
+	var remove func()
+	remove = c.AddOverlayEvents(&OverlayEvents{OnToggle: func(locked bool) {
+		calls = append(calls, locked)
+		remove()
+	}})
+	c.AddOverlayEvents(&OverlayEvents{OnToggle: func(locked bool) { calls = append(calls, locked) }})
+
+	dcgo.CoreOnOverlayToggle(data, true)
+	dcgo.CoreOnOverlayToggle(data, false)
+	if len(calls) != 3 || !calls[0] || !calls[1] || calls[2] {
+		t.Errorf("calls = %v, want [true true false]", calls)
+	}
+}
+
+func TestCreateInstallsCoreEvents(t *testing.T) {
+	var speaking []int64
+	events := NewCoreEvents()
+	events.SetLobbyEvents(&LobbyEvents{OnSpeaking: func(lobbyID, userID int64, on bool) {
+		if on {
+			speaking = append(speaking, userID)
+		}
+	}})
+
+	c := &Core{}
+	c.events.install(events)
+	data := eventData(t, c)
+	dcgo.CoreOnSpeaking(data, 7, 42, true)
+	dcgo.CoreOnSpeaking(data, 7, 42, false)
+	if len(speaking) != 1 || speaking[0] != 42 {
+		t.Errorf("speaking = %v, want [42]", speaking)
+	}
+}
+
+func TestRelationshipUpdateEvent(t *testing.T) {
+	c := &Core{}
+	var got []*Relationship
+	for range 2 {
+		c.AddRelationshipEvents(&RelationshipEvents{OnRelationshipUpdate: func(rel *Relationship) {
+			got = append(got, rel)
+		}})
+	}
+
+	want := Relationship{
+		Type:     RelationshipTypeFriend,
+		User:     User{ID: 99, Username: "friend"},
+		Presence: Presence{Status: StatusOnline, Activity: Activity{Name: "Game"}},
+	}
+	rel := relationshipToC(&want)
+	c.events.handlers().OnRelationshipUpdate(unsafe.Pointer(&rel))
+
+	if len(got) != 2 {
+		t.Fatalf("got %d updates, want 2", len(got))
+	}
+	if *got[0] != want || *got[1] != want {
+		t.Errorf("update = %+v, want %+v", *got[0], want)
+	}
+	if got[0] == got[1] {
+		t.Error("subscribers share the same Relationship value")
+	}
+}
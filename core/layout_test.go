@@ -0,0 +1,29 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/andresperezl/discordgamesdk-go/internal/sdklayout"
+)
+
+// checkLayout compares the size and field offsets of a Go mirror with the C struct it mirrors
+func checkLayout(t *testing.T, name string, mirror sdklayout.Struct) {
+	t.Helper()
+	want, ok := sdklayout.Layouts()[name]
+	if !ok {
+		t.Fatalf("no C layout for %s", name)
+	}
+	if mirror.Size != want.Size {
+		t.Errorf("%s: size = %d, C size = %d", name, mirror.Size, want.Size)
+	}
+	for field, offset := range want.Offsets {
+		got, ok := mirror.Offsets[field]
+		if !ok {
+			t.Errorf("%s.%s: not checked by the Go mirror", name, field)
+			continue
+		}
+		if got != offset {
+			t.Errorf("%s.%s: offset = %d, C offset = %d", name, field, got, offset)
+		}
+	}
+}
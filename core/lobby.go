@@ -402,6 +402,54 @@ func (lm *LobbyManager) ConnectLobbyWithActivitySecret(activitySecret string, ca
 	})
 }
 
+// ConnectLobbyWithActivitySecretAsync connects to a lobby using an activity secret, such as the
+// join secret of a friend's activity, and calls callback with the result and the joined lobby
+func (lm *LobbyManager) ConnectLobbyWithActivitySecretAsync(activitySecret string, callback func(result Result, lobby *Lobby)) {
+	if lm.manager == nil {
+		if callback != nil {
+			callback(ResultInternalError, nil)
+		}
+		return
+	}
+
+	var secret [128]byte
+	copyCString(secret[:], activitySecret)
+	dcgo.RunOnDispatcherSync(func() any {
+		dcgo.LobbyManagerConnectLobbyWithActivitySecretGo(lm.manager, unsafe.Pointer(&secret[0]), func(result int32, lobby unsafe.Pointer) {
+			if callback == nil {
+				return
+			}
+			if lobby == nil {
+				callback(Result(result), nil)
+				return
+			}
+			callback(Result(result), lobbyFromC((*cDiscordLobby)(lobby)))
+		})
+		return nil
+	})
+}
+
+// cDiscordLobby mirrors the memory layout of the C DiscordLobby struct
+type cDiscordLobby struct {
+	ID       int64
+	Type     int32
+	OwnerID  int64
+	Secret   [128]byte
+	Capacity uint32
+	Locked   bool
+}
+
+func lobbyFromC(c *cDiscordLobby) *Lobby {
+	return &Lobby{
+		ID:       c.ID,
+		Type:     LobbyType(c.Type),
+		OwnerID:  c.OwnerID,
+		Secret:   cString(c.Secret[:]),
+		Capacity: c.Capacity,
+		Locked:   c.Locked,
+	}
+}
+
 // GetMemberUpdateTransaction gets a member update transaction
 func (lm *LobbyManager) GetMemberUpdateTransaction(lobbyID, userID int64) unsafe.Pointer {
 	var transaction unsafe.Pointer
@@ -420,7 +468,7 @@ func (lm *LobbyManager) GetLobbyMetadataValue(lobbyID int64, key string) (string
 	res := dcgo.RunOnDispatcherSync(func() int32 {
 		return dcgo.LobbyManagerGetLobbyMetadataValue(lm.manager, lobbyID, unsafe.Pointer(cKey), unsafe.Pointer(&value[0]))
 	})
-	return dcgo.GoString(cKey), res
+	return cString(value[:]), res
 }
 
 // GetLobbyMetadataKey retrieves a metadata key for a lobby by index
@@ -461,11 +509,15 @@ func (lm *LobbyManager) GetMemberUserID(lobbyID int64, index int32) (int64, int3
 
 // GetMemberUser retrieves a user struct for a member
 func (lm *LobbyManager) GetMemberUser(lobbyID, userID int64) (*User, int32) {
-	var user User
+	var user cDiscordUser
 	res := dcgo.RunOnDispatcherSync(func() int32 {
 		return dcgo.LobbyManagerGetMemberUser(lm.manager, lobbyID, userID, unsafe.Pointer(&user))
 	})
-	return &user, res
+	if res != 0 {
+		return nil, res
+	}
+	u := userFromC(&user)
+	return &u, res
 }
 
 // GetMemberMetadataValue retrieves a metadata value for a member
@@ -476,7 +528,7 @@ func (lm *LobbyManager) GetMemberMetadataValue(lobbyID, userID int64, key string
 	res := dcgo.RunOnDispatcherSync(func() int32 {
 		return dcgo.LobbyManagerGetMemberMetadataValue(lm.manager, lobbyID, userID, unsafe.Pointer(cKey), unsafe.Pointer(&value[0]))
 	})
-	return dcgo.GoString(cKey), res
+	return cString(value[:]), res
 }
 
 // GetMemberMetadataKey retrieves a metadata key for a member by index
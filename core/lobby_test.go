@@ -0,0 +1,49 @@
+package core
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/andresperezl/discordgamesdk-go/internal/sdkfake"
+	"github.com/andresperezl/discordgamesdk-go/internal/sdklayout"
+)
+
+func TestLobbyMirrorLayout(t *testing.T) {
+	var lobby cDiscordLobby
+	checkLayout(t, "DiscordLobby", sdklayout.Struct{
+		Size: unsafe.Sizeof(lobby),
+		Offsets: map[string]uintptr{
+			"id":       unsafe.Offsetof(lobby.ID),
+			"type":     unsafe.Offsetof(lobby.Type),
+			"owner_id": unsafe.Offsetof(lobby.OwnerID),
+			"secret":   unsafe.Offsetof(lobby.Secret),
+			"capacity": unsafe.Offsetof(lobby.Capacity),
+			"locked":   unsafe.Offsetof(lobby.Locked),
+		},
+	})
+}
+
+func TestLobbyFromC(t *testing.T) {
+	c := cDiscordLobby{ID: 11, Type: int32(LobbyTypePublic), OwnerID: 22, Capacity: 4, Locked: true}
+	copy(c.Secret[:], "secret\x00garbage")
+	want := Lobby{ID: 11, Type: LobbyTypePublic, OwnerID: 22, Secret: "secret", Capacity: 4, Locked: true}
+	if got := lobbyFromC(&c); *got != want {
+		t.Errorf("lobbyFromC = %+v, want %+v", *got, want)
+	}
+}
+
+func TestMetadataValue(t *testing.T) {
+	manager, free := sdkfake.LobbyManager("team", "red")
+	defer free()
+	lm := &LobbyManager{manager: manager}
+
+	if value, res := lm.GetLobbyMetadataValue(1, "team"); res != int32(ResultOk) || value != "red" {
+		t.Errorf("GetLobbyMetadataValue(team) = %q, %v, want \"red\", ResultOk", value, Result(res))
+	}
+	if value, res := lm.GetMemberMetadataValue(1, 2, "team"); res != int32(ResultOk) || value != "red" {
+		t.Errorf("GetMemberMetadataValue(team) = %q, %v, want \"red\", ResultOk", value, Result(res))
+	}
+	if value, res := lm.GetMemberMetadataValue(1, 2, "role"); res != int32(ResultNotFound) || value != "" {
+		t.Errorf("GetMemberMetadataValue(role) = %q, %v, want \"\", ResultNotFound", value, Result(res))
+	}
+}
@@ -0,0 +1,227 @@
+package core
+
+import (
+	"sync"
+	"unsafe"
+
+	dcgo "github.com/andresperezl/discordgamesdk-go/discordcgo"
+)
+
+// cDiscordUser mirrors the memory layout of the C DiscordUser struct
+type cDiscordUser struct {
+	ID            int64
+	Username      [256]byte
+	Discriminator [8]byte
+	Avatar        [128]byte
+	Bot           bool
+}
+
+// cDiscordActivity mirrors the memory layout of the C DiscordActivity struct
+type cDiscordActivity struct {
+	Type          int32
+	ApplicationID int64
+	Name          [128]byte
+	State         [128]byte
+	Details       [128]byte
+	Timestamps    struct {
+		Start int64
+		End   int64
+	}
+	Assets struct {
+		LargeImage [128]byte
+		LargeText  [128]byte
+		SmallImage [128]byte
+		SmallText  [128]byte
+	}
+	Party struct {
+		ID   [128]byte
+		Size struct {
+			CurrentSize int32
+			MaxSize     int32
+		}
+		Privacy int32
+	}
+	Secrets struct {
+		Match    [128]byte
+		Join     [128]byte
+		Spectate [128]byte
+	}
+	Instance           bool
+	SupportedPlatforms uint32
+}
+
+// cDiscordPresence mirrors the memory layout of the C DiscordPresence struct
+type cDiscordPresence struct {
+	Status   int32
+	Activity cDiscordActivity
+}
+
+// cDiscordRelationship mirrors the memory layout of the C DiscordRelationship struct
+type cDiscordRelationship struct {
+	Type     int32
+	User     cDiscordUser
+	Presence cDiscordPresence
+}
+
+// FilterFunc calls predicate for every relationship and keeps those it accepts,
+// so Count and GetAt only see the matching relationships afterwards. The filter is
+// kept and re-applied after every Snapshot, which runs predicate again.
+// The predicate runs synchronously on the SDK thread and must not call into the SDK.
+func (rm *RelationshipManager) FilterFunc(predicate func(rel *Relationship) bool) {
+	if rm.manager == nil || predicate == nil {
+		return
+	}
+	apply := func() {
+		dcgo.RelationshipManagerFilterGo(rm.manager, func(relationship unsafe.Pointer) bool {
+			return predicate(relationshipFromC((*cDiscordRelationship)(relationship)))
+		})
+	}
+	relationshipFilters.Store(rm.manager, apply)
+	apply()
+}
+
+// relationshipFilters holds the last filter applied to each relationship manager,
+// so Snapshot can put it back after reading every relationship
+var relationshipFilters sync.Map // map[unsafe.Pointer]func()
+
+// Snapshot reads every relationship without changing the filter seen by Count and GetAt,
+// then calls apply with them. It re-applies the last Filter or FilterFunc filter, whose
+// predicate therefore runs again.
+//
+// The read and apply run on the SDK thread with nothing in between, so relationship events
+// are delivered either before the snapshot or after apply returns. apply must not call into the SDK.
+func (rm *RelationshipManager) Snapshot(apply func(rels []Relationship)) {
+	if rm.manager == nil || apply == nil {
+		return
+	}
+	dcgo.RunOnDispatcherSync(func() any {
+		var rels []Relationship
+		dcgo.RelationshipManagerFilterGo(rm.manager, func(relationship unsafe.Pointer) bool {
+			rels = append(rels, *relationshipFromC((*cDiscordRelationship)(relationship)))
+			return true
+		})
+		if restore, ok := relationshipFilters.Load(rm.manager); ok {
+			restore.(func())()
+		}
+		apply(rels)
+		return nil
+	})
+}
+
+func relationshipFromC(c *cDiscordRelationship) *Relationship {
+	return &Relationship{
+		Type: RelationshipType(c.Type),
+		User: userFromC(&c.User),
+		Presence: Presence{
+			Status:   Status(c.Presence.Status),
+			Activity: activityFromC(&c.Presence.Activity),
+		},
+	}
+}
+
+func userFromC(c *cDiscordUser) User {
+	return User{
+		ID:            c.ID,
+		Username:      cString(c.Username[:]),
+		Discriminator: cString(c.Discriminator[:]),
+		Avatar:        cString(c.Avatar[:]),
+		Bot:           c.Bot,
+	}
+}
+
+func activityFromC(c *cDiscordActivity) Activity {
+	return Activity{
+		Type:          ActivityType(c.Type),
+		ApplicationID: c.ApplicationID,
+		Name:          cString(c.Name[:]),
+		State:         cString(c.State[:]),
+		Details:       cString(c.Details[:]),
+		Timestamps: ActivityTimestamps{
+			Start: c.Timestamps.Start,
+			End:   c.Timestamps.End,
+		},
+		Assets: ActivityAssets{
+			LargeImage: cString(c.Assets.LargeImage[:]),
+			LargeText:  cString(c.Assets.LargeText[:]),
+			SmallImage: cString(c.Assets.SmallImage[:]),
+			SmallText:  cString(c.Assets.SmallText[:]),
+		},
+		Party: ActivityParty{
+			ID: cString(c.Party.ID[:]),
+			Size: PartySize{
+				CurrentSize: c.Party.Size.CurrentSize,
+				MaxSize:     c.Party.Size.MaxSize,
+			},
+			Privacy: ActivityPartyPrivacy(c.Party.Privacy),
+		},
+		Secrets: ActivitySecrets{
+			Match:    cString(c.Secrets.Match[:]),
+			Join:     cString(c.Secrets.Join[:]),
+			Spectate: cString(c.Secrets.Spectate[:]),
+		},
+		Instance:           c.Instance,
+		SupportedPlatforms: c.SupportedPlatforms,
+	}
+}
+
+func relationshipToC(rel *Relationship) cDiscordRelationship {
+	return cDiscordRelationship{
+		Type: int32(rel.Type),
+		User: userToC(&rel.User),
+		Presence: cDiscordPresence{
+			Status:   int32(rel.Presence.Status),
+			Activity: activityToC(&rel.Presence.Activity),
+		},
+	}
+}
+
+func userToC(user *User) cDiscordUser {
+	var c cDiscordUser
+	c.ID = user.ID
+	copyCString(c.Username[:], user.Username)
+	copyCString(c.Discriminator[:], user.Discriminator)
+	copyCString(c.Avatar[:], user.Avatar)
+	c.Bot = user.Bot
+	return c
+}
+
+func activityToC(activity *Activity) cDiscordActivity {
+	var c cDiscordActivity
+	c.Type = int32(activity.Type)
+	c.ApplicationID = activity.ApplicationID
+	copyCString(c.Name[:], activity.Name)
+	copyCString(c.State[:], activity.State)
+	copyCString(c.Details[:], activity.Details)
+	c.Timestamps.Start = activity.Timestamps.Start
+	c.Timestamps.End = activity.Timestamps.End
+	copyCString(c.Assets.LargeImage[:], activity.Assets.LargeImage)
+	copyCString(c.Assets.LargeText[:], activity.Assets.LargeText)
+	copyCString(c.Assets.SmallImage[:], activity.Assets.SmallImage)
+	copyCString(c.Assets.SmallText[:], activity.Assets.SmallText)
+	copyCString(c.Party.ID[:], activity.Party.ID)
+	c.Party.Size.CurrentSize = activity.Party.Size.CurrentSize
+	c.Party.Size.MaxSize = activity.Party.Size.MaxSize
+	c.Party.Privacy = int32(activity.Party.Privacy)
+	copyCString(c.Secrets.Match[:], activity.Secrets.Match)
+	copyCString(c.Secrets.Join[:], activity.Secrets.Join)
+	copyCString(c.Secrets.Spectate[:], activity.Secrets.Spectate)
+	c.Instance = activity.Instance
+	c.SupportedPlatforms = activity.SupportedPlatforms
+	return c
+}
+
+// copyCString copies s into a C char array, truncating it so the array stays NUL-terminated
+func copyCString(dst []byte, s string) {
+	n := copy(dst[:len(dst)-1], s)
+	clear(dst[n:])
+}
+
+// cString converts a NUL-terminated C char array to a Go string
+func cString(b []byte) string {
+	for i, c := range b {
+		if c == 0 {
+			return string(b[:i])
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/andresperezl/discordgamesdk-go/internal/sdklayout"
+)
+
+func TestRelationshipMirrorLayouts(t *testing.T) {
+	var user cDiscordUser
+	var activity cDiscordActivity
+	var presence cDiscordPresence
+	var rel cDiscordRelationship
+
+	checkLayout(t, "DiscordUser", sdklayout.Struct{
+		Size: unsafe.Sizeof(user),
+		Offsets: map[string]uintptr{
+			"id":            unsafe.Offsetof(user.ID),
+			"username":      unsafe.Offsetof(user.Username),
+			"discriminator": unsafe.Offsetof(user.Discriminator),
+			"avatar":        unsafe.Offsetof(user.Avatar),
+			"bot":           unsafe.Offsetof(user.Bot),
+		},
+	})
+	checkLayout(t, "DiscordActivity", sdklayout.Struct{
+		Size: unsafe.Sizeof(activity),
+		Offsets: map[string]uintptr{
+			"type":                unsafe.Offsetof(activity.Type),
+			"application_id":      unsafe.Offsetof(activity.ApplicationID),
+			"name":                unsafe.Offsetof(activity.Name),
+			"state":               unsafe.Offsetof(activity.State),
+			"details":             unsafe.Offsetof(activity.Details),
+			"timestamps":          unsafe.Offsetof(activity.Timestamps),
+			"assets":              unsafe.Offsetof(activity.Assets),
+			"party":               unsafe.Offsetof(activity.Party),
+			"party.size":          unsafe.Offsetof(activity.Party.Size),
+			"party.privacy":       unsafe.Offsetof(activity.Party.Privacy),
+			"secrets":             unsafe.Offsetof(activity.Secrets),
+			"instance":            unsafe.Offsetof(activity.Instance),
+			"supported_platforms": unsafe.Offsetof(activity.SupportedPlatforms),
+		},
+	})
+	checkLayout(t, "DiscordPresence", sdklayout.Struct{
+		Size: unsafe.Sizeof(presence),
+		Offsets: map[string]uintptr{
+			"status":   unsafe.Offsetof(presence.Status),
+			"activity": unsafe.Offsetof(presence.Activity),
+		},
+	})
+	checkLayout(t, "DiscordRelationship", sdklayout.Struct{
+		Size: unsafe.Sizeof(rel),
+		Offsets: map[string]uintptr{
+			"type":     unsafe.Offsetof(rel.Type),
+			"user":     unsafe.Offsetof(rel.User),
+			"presence": unsafe.Offsetof(rel.Presence),
+		},
+	})
+}
+
+func TestRelationshipRoundTrip(t *testing.T) {
+	want := Relationship{
+		Type: RelationshipTypeFriend,
+		User: User{
+			ID:            1234567890123,
+			Username:      "player",
+			Discriminator: "0420",
+			Avatar:        "a_1f2e3d",
+			Bot:           true,
+		},
+		Presence: Presence{
+			Status: StatusDoNotDisturb,
+			Activity: Activity{
+				Type:          ActivityTypeListening,
+				ApplicationID: 987654321,
+				Name:          "Game",
+				State:         "In Queue",
+				Details:       "Ranked",
+				Timestamps:    ActivityTimestamps{Start: 1700000000, End: 1700003600},
+				Assets: ActivityAssets{
+					LargeImage: "large",
+					LargeText:  "Large text",
+					SmallImage: "small",
+					SmallText:  "Small text",
+				},
+				Party: ActivityParty{
+					ID:      "party-1",
+					Size:    PartySize{CurrentSize: 2, MaxSize: 5},
+					Privacy: ActivityPartyPrivacyPublic,
+				},
+				Secrets: ActivitySecrets{
+					Match:    "match-secret",
+					Join:     "join-secret",
+					Spectate: "spectate-secret",
+				},
+				Instance:           true,
+				SupportedPlatforms: 3,
+			},
+		},
+	}
+
+	c := relationshipToC(&want)
+	got := relationshipFromC(&c)
+	if *got != want {
+		t.Errorf("round trip mismatch:\n got  %+v\n want %+v", *got, want)
+	}
+}
+
+func TestCopyCStringTruncates(t *testing.T) {
+	var user User
+	user.Username = strings.Repeat("x", 300)
+	c := userToC(&user)
+	if c.Username[len(c.Username)-1] != 0 {
+		t.Fatal("username is not NUL-terminated")
+	}
+	if got := userFromC(&c); len(got.Username) != len(c.Username)-1 {
+		t.Errorf("username length = %d, want %d", len(got.Username), len(c.Username)-1)
+	}
+}
@@ -40,12 +40,19 @@ func (s *StorageManager) ReadAsync(name string, callback func(result Result, dat
 	})
 }
 
-// ReadAsyncPartial reads partial data from storage asynchronously
+// ReadAsyncPartial reads length bytes starting at offset from storage asynchronously
 func (s *StorageManager) ReadAsyncPartial(name string, offset, length uint64, callback func(result Result, data []byte)) {
-	// Not implemented: would require cgo callback trampoline
-	if callback != nil {
-		callback(ResultInternalError, nil)
+	if s.manager == nil {
+		if callback != nil {
+			callback(ResultInternalError, nil)
+		}
+		return
 	}
+	dcgo.StorageManagerReadAsyncPartialGo(s.manager, name, offset, length, func(result int32, data []byte) {
+		if callback != nil {
+			callback(Result(result), data)
+		}
+	})
 }
 
 // Write writes data to storage
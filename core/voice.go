@@ -6,37 +6,60 @@ import (
 	dcgo "github.com/andresperezl/discordgamesdk-go/discordcgo"
 )
 
+// cDiscordInputMode mirrors the memory layout of the C DiscordInputMode struct
+type cDiscordInputMode struct {
+	Type     int32
+	Shortcut [256]byte
+}
+
+func inputModeToC(mode *InputMode) cDiscordInputMode {
+	var c cDiscordInputMode
+	c.Type = int32(mode.Type)
+	copyCString(c.Shortcut[:], mode.Shortcut)
+	return c
+}
+
 // SetInputMode sets the input mode
+//
+// Deprecated: the result of the change is not reported. Use SetInputModeAsync.
 func (v *VoiceManager) SetInputMode(mode InputMode) Result {
 	if v.manager == nil {
 		return ResultInternalError
 	}
-	var cMode struct {
-		Type     int32
-		Shortcut [256]byte
-	}
-	cMode.Type = int32(mode.Type)
-	copy(cMode.Shortcut[:], mode.Shortcut)
+	cMode := inputModeToC(&mode)
 	dcgo.VoiceManagerSetInputMode(v.manager, unsafe.Pointer(&cMode), nil, nil)
 	return ResultOk // TODO: callback support
 }
 
+// SetInputModeAsync sets the input mode and calls callback with the result once Discord applied it
+func (v *VoiceManager) SetInputModeAsync(mode InputMode, callback func(result Result)) {
+	if v.manager == nil {
+		if callback != nil {
+			callback(ResultInternalError)
+		}
+		return
+	}
+	cMode := inputModeToC(&mode)
+	dcgo.VoiceManagerSetInputModeGo(v.manager, unsafe.Pointer(&cMode), func(result int32) {
+		if callback != nil {
+			callback(Result(result))
+		}
+	})
+}
+
 // GetInputMode gets the input mode
 func (v *VoiceManager) GetInputMode() (InputMode, Result) {
 	if v.manager == nil {
 		return InputMode{}, ResultInternalError
 	}
-	var cMode struct {
-		Type     int32
-		Shortcut [256]byte
-	}
+	var cMode cDiscordInputMode
 	res := dcgo.VoiceManagerGetInputMode(v.manager, unsafe.Pointer(&cMode))
 	if res != 0 {
 		return InputMode{}, Result(res)
 	}
 	return InputMode{
 		Type:     InputModeType(cMode.Type),
-		Shortcut: string(cMode.Shortcut[:]),
+		Shortcut: cString(cMode.Shortcut[:]),
 	}, ResultOk
 }
 
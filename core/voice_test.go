@@ -0,0 +1,27 @@
+package core
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/andresperezl/discordgamesdk-go/internal/sdklayout"
+)
+
+func TestInputModeMirrorLayout(t *testing.T) {
+	var inputMode cDiscordInputMode
+	checkLayout(t, "DiscordInputMode", sdklayout.Struct{
+		Size: unsafe.Sizeof(inputMode),
+		Offsets: map[string]uintptr{
+			"type":     unsafe.Offsetof(inputMode.Type),
+			"shortcut": unsafe.Offsetof(inputMode.Shortcut),
+		},
+	})
+}
+
+func TestInputModeToC(t *testing.T) {
+	mode := InputMode{Type: InputModeTypePushToTalk, Shortcut: "ctrl + shift + t"}
+	c := inputModeToC(&mode)
+	if InputModeType(c.Type) != mode.Type || cString(c.Shortcut[:]) != mode.Shortcut {
+		t.Errorf("inputModeToC = {%d %q}, want %+v", c.Type, cString(c.Shortcut[:]), mode)
+	}
+}
@@ -17,6 +17,8 @@ typedef struct DiscordOAuth2Token DiscordOAuth2Token;
 // Typedef for the Go callback trampoline
 typedef void (*go_storage_read_async_callback_t)(void* go_callback_data, enum EDiscordResult result, uint8_t* data, uint32_t data_length);
 extern void go_storage_read_async_callback_trampoline(void* go_callback_data, enum EDiscordResult result, uint8_t* data, uint32_t data_length);
+extern void go_storage_read_async_partial_callback_trampoline(void* go_callback_data, enum EDiscordResult result, uint8_t* data, uint32_t data_length);
+extern bool go_relationship_filter_trampoline(void* go_filter_data, struct DiscordRelationship* relationship);
 
 // Add extern for the Go lobby manager create lobby callback trampoline
 extern void LobbyManagerCreateLobbyCallback(void* callbackData, enum EDiscordResult result, struct DiscordLobby* lobby);
@@ -37,6 +39,7 @@ import (
 	"runtime"
 	runtimecgo "runtime/cgo"
 	"sync"
+	"sync/atomic"
 	"unsafe"
 
 	discordlog "github.com/andresperezl/discordgamesdk-go/discordlog"
@@ -69,7 +72,7 @@ func GetLogger() *slog.Logger {
 	return discordlog.GetLogger()
 }
 
-var dispatcherThreadID uint64
+var dispatcherThreadID atomic.Uint64
 
 // Dispatcher for serializing all SDK calls on a single OS thread
 type sdkCall func()
@@ -87,7 +90,7 @@ func (d *sdkDispatcher) start() {
 	d.once.Do(func() {
 		go func() {
 			runtime.LockOSThread()
-			dispatcherThreadID = getCurrentThreadID()
+			dispatcherThreadID.Store(getCurrentThreadID())
 			for call := range d.calls {
 				call()
 			}
@@ -103,7 +106,7 @@ func runOnDispatcher(call sdkCall) {
 // Run a function on the dispatcher and wait for its result
 func RunOnDispatcherSync[T any](fn func() T) T {
 	dispatcher.start()
-	if getCurrentThreadID() == dispatcherThreadID {
+	if getCurrentThreadID() == dispatcherThreadID.Load() {
 		return fn()
 	}
 	ch := make(chan T, 1)
@@ -165,6 +168,83 @@ func StorageManagerWriteAsync(manager unsafe.Pointer, name *C.char, data unsafe.
 	})
 }
 
+// StorageManagerReadAsyncPartialGo reads length bytes starting at offset from the named file.
+// The C string for name is owned by the dispatched call, and the data is copied into Go
+// memory before the callback runs, since the SDK buffer is only valid during the callback.
+func StorageManagerReadAsyncPartialGo(manager unsafe.Pointer, name string, offset, length uint64, goCallback func(result int32, data []byte)) {
+	handle := runtimecgo.NewHandle(goCallback)
+	runOnDispatcher(func() {
+		cname := C.CString(name)
+		defer C.free(unsafe.Pointer(cname))
+		C.discord_storage_manager_read_async_partial_trampoline(
+			(*C.struct_IDiscordStorageManager)(manager),
+			cname,
+			C.uint64_t(offset),
+			C.uint64_t(length),
+			unsafe.Pointer(handle),
+		)
+	})
+}
+
+//export go_storage_read_async_partial_callback_trampoline
+func go_storage_read_async_partial_callback_trampoline(go_callback_data unsafe.Pointer, result C.enum_EDiscordResult, data *C.uint8_t, data_length C.uint32_t) {
+	if go_callback_data == nil {
+		return
+	}
+	handle := runtimecgo.Handle(go_callback_data)
+	cb, ok := handle.Value().(func(int32, []byte))
+	if ok {
+		var goData []byte
+		if data != nil && data_length > 0 {
+			goData = C.GoBytes(unsafe.Pointer(data), C.int(data_length))
+		}
+		cb(int32(result), goData)
+	}
+	handle.Delete()
+}
+
+// EventHandlers receives the events the SDK raises for one core. Struct arguments point to
+// SDK-owned C memory and are only valid for the duration of the call.
+type EventHandlers struct {
+	OnCurrentUserUpdate     func()
+	OnActivityJoin          func(secret string)
+	OnActivitySpectate      func(secret string)
+	OnActivityJoinRequest   func(user unsafe.Pointer)
+	OnActivityInvite        func(actionType int32, user unsafe.Pointer, activity unsafe.Pointer)
+	OnRelationshipRefresh   func()
+	OnRelationshipUpdate    func(relationship unsafe.Pointer)
+	OnLobbyUpdate           func(lobbyID int64)
+	OnLobbyDelete           func(lobbyID int64, reason uint32)
+	OnMemberConnect         func(lobbyID int64, userID int64)
+	OnMemberUpdate          func(lobbyID int64, userID int64)
+	OnMemberDisconnect      func(lobbyID int64, userID int64)
+	OnLobbyMessage          func(lobbyID int64, userID int64, data []byte)
+	OnSpeaking              func(lobbyID int64, userID int64, speaking bool)
+	OnLobbyNetworkMessage   func(lobbyID int64, userID int64, channelID uint8, data []byte)
+	OnNetworkMessage        func(peerID uint64, channelID uint8, data []byte)
+	OnRouteUpdate           func(routeData string)
+	OnOverlayToggle         func(locked bool)
+	OnEntitlementCreate     func(entitlement unsafe.Pointer)
+	OnEntitlementDelete     func(entitlement unsafe.Pointer)
+	OnVoiceSettingsUpdate   func()
+	OnUserAchievementUpdate func(userAchievement unsafe.Pointer)
+}
+
+// Event data of the live cores, keyed by core pointer
+var coreEventData sync.Map // map[unsafe.Pointer]func()
+
+// NewEventData stores a handle to handlers in C memory and returns a pointer to it, which
+// is what the SDK passes back as event_data. The returned function frees both.
+func NewEventData(handlers *EventHandlers) (unsafe.Pointer, func()) {
+	handle := runtimecgo.NewHandle(handlers)
+	slot := (*C.uintptr_t)(C.malloc(C.size_t(unsafe.Sizeof(C.uintptr_t(0)))))
+	*slot = C.uintptr_t(handle)
+	return unsafe.Pointer(slot), func() {
+		C.free(unsafe.Pointer(slot))
+		handle.Delete()
+	}
+}
+
 // Core wrappers
 func CoreCreate(version int32, params unsafe.Pointer, result unsafe.Pointer) int32 {
 	return RunOnDispatcherSync(func() int32 {
@@ -172,13 +252,28 @@ func CoreCreate(version int32, params unsafe.Pointer, result unsafe.Pointer) int
 	})
 }
 
-// CoreCreateHelper returns (unsafe.Pointer, int32) but RunOnDispatcherSync cannot infer tuple types, so split into two calls
+// CoreCreateHelper creates a core without event handlers
 func CoreCreateHelper(clientID int64, flags uint64) (unsafe.Pointer, int32) {
+	return CoreCreateWithEvents(clientID, flags, nil)
+}
+
+// CoreCreateWithEvents creates a core whose SDK events are passed to handlers. Events are raised
+// on the dispatcher thread while CoreRunCallbacks runs.
+// It returns (unsafe.Pointer, int32) but RunOnDispatcherSync cannot infer tuple types, so the results are captured
+func CoreCreateWithEvents(clientID int64, flags uint64, handlers *EventHandlers) (unsafe.Pointer, int32) {
 	var corePtr unsafe.Pointer
 	var result int32
+	var eventData unsafe.Pointer
+	var free func()
+	if handlers != nil {
+		eventData, free = NewEventData(handlers)
+	}
 	RunOnDispatcherSync(func() any {
 		var params C.struct_DiscordCreateParams
 		C.DiscordCreateParamsSetDefault(&params)
+		if eventData != nil {
+			C.discord_create_params_set_events(&params, eventData)
+		}
 		params.client_id = C.DiscordClientId(clientID)
 		params.flags = C.uint64_t(flags)
 		params.application_version = C.DISCORD_APPLICATION_MANAGER_VERSION
@@ -198,6 +293,13 @@ func CoreCreateHelper(clientID int64, flags uint64) (unsafe.Pointer, int32) {
 		corePtr = unsafe.Pointer(core)
 		return nil
 	})
+	if free != nil {
+		if result != 0 {
+			free()
+		} else {
+			coreEventData.Store(corePtr, free)
+		}
+	}
 	return corePtr, result
 }
 
@@ -206,6 +308,9 @@ func CoreDestroy(core unsafe.Pointer) {
 		C.discord_core_destroy(core)
 		return nil
 	})
+	if free, ok := coreEventData.LoadAndDelete(core); ok {
+		free.(func())()
+	}
 }
 
 func CoreRunCallbacks(core unsafe.Pointer) int32 {
@@ -1070,6 +1175,30 @@ func RelationshipManagerGetAt(manager unsafe.Pointer, index uint32, relationship
 	})
 }
 
+// RelationshipManagerFilterGo filters relationships with a Go predicate. The SDK calls the
+// filter synchronously for every relationship before filter returns, passing a pointer to a
+// C DiscordRelationship that is only valid during the call.
+func RelationshipManagerFilterGo(manager unsafe.Pointer, filter func(relationship unsafe.Pointer) bool) {
+	handle := runtimecgo.NewHandle(filter)
+	defer handle.Delete()
+	RunOnDispatcherSync(func() any {
+		C.discord_relationship_manager_filter_trampoline((*C.struct_IDiscordRelationshipManager)(manager), unsafe.Pointer(handle))
+		return nil
+	})
+}
+
+//export go_relationship_filter_trampoline
+func go_relationship_filter_trampoline(go_filter_data unsafe.Pointer, relationship *C.struct_DiscordRelationship) C.bool {
+	if go_filter_data == nil || relationship == nil {
+		return C.bool(false)
+	}
+	filter, ok := runtimecgo.Handle(go_filter_data).Value().(func(unsafe.Pointer) bool)
+	if !ok || filter == nil {
+		return C.bool(false)
+	}
+	return C.bool(filter(unsafe.Pointer(relationship)))
+}
+
 func LobbyManagerGetMemberUpdateTransaction(manager unsafe.Pointer, lobbyID int64, userID int64, transaction unsafe.Pointer) int32 {
 	return RunOnDispatcherSync(func() int32 {
 		return int32(C.discord_lobby_manager_get_member_update_transaction((*C.struct_IDiscordLobbyManager)(manager), C.DiscordLobbyId(lobbyID), C.DiscordUserId(userID), (**C.struct_IDiscordLobbyMemberTransaction)(transaction)))
@@ -1472,7 +1601,7 @@ func StoreManagerStartPurchase(manager unsafe.Pointer, skuID int64, callbackData
 
 // RelationshipManager wrappers
 func RelationshipManagerFilter(manager unsafe.Pointer, filterData unsafe.Pointer, filter unsafe.Pointer) {
-	// TODO: Implement proper callback support
+	// Raw C filters are not supported; use RelationshipManagerFilterGo
 }
 
 // LobbyManager additional wrappers
@@ -1527,16 +1656,18 @@ func VoiceManagerSetInputMode(manager unsafe.Pointer, inputMode unsafe.Pointer,
 
 // --- BEGIN: Async Trampolines and Go-friendly Methods for Stubs ---
 
-// AchievementManagerSetUserAchievementGo
+// AchievementManagerSetUserAchievementGo sets the current user's progress on an achievement
+// and invokes goCallback with the result
 func AchievementManagerSetUserAchievementGo(manager unsafe.Pointer, achievementID int64, percentComplete uint8, goCallback func(result int32)) {
 	handle := runtimecgo.NewHandle(goCallback)
-	C.discord_achievement_manager_set_user_achievement(
-		(*C.struct_IDiscordAchievementManager)(manager),
-		C.DiscordSnowflake(achievementID),
-		C.uint8_t(percentComplete),
-		unsafe.Pointer(handle),
-		nil, // callback pointer is handled by Go trampoline
-	)
+	runOnDispatcher(func() {
+		C.discord_achievement_manager_set_user_achievement_trampoline(
+			(*C.struct_IDiscordAchievementManager)(manager),
+			C.DiscordSnowflake(achievementID),
+			C.uint8_t(percentComplete),
+			unsafe.Pointer(handle),
+		)
+	})
 }
 
 //export AchievementManagerSetUserAchievementCallback
@@ -1546,20 +1677,22 @@ func AchievementManagerSetUserAchievementCallback(callbackData unsafe.Pointer, r
 	}
 	handle := runtimecgo.Handle(callbackData)
 	cb, ok := handle.Value().(func(int32))
-	if ok {
+	if ok && cb != nil {
 		cb(int32(result))
 	}
 	handle.Delete()
 }
 
-// AchievementManagerFetchUserAchievementsGo
+// AchievementManagerFetchUserAchievementsGo fetches the current user's achievements and
+// invokes goCallback with the result
 func AchievementManagerFetchUserAchievementsGo(manager unsafe.Pointer, goCallback func(result int32)) {
 	handle := runtimecgo.NewHandle(goCallback)
-	C.discord_achievement_manager_fetch_user_achievements(
-		(*C.struct_IDiscordAchievementManager)(manager),
-		unsafe.Pointer(handle),
-		nil, // callback pointer is handled by Go trampoline
-	)
+	runOnDispatcher(func() {
+		C.discord_achievement_manager_fetch_user_achievements_trampoline(
+			(*C.struct_IDiscordAchievementManager)(manager),
+			unsafe.Pointer(handle),
+		)
+	})
 }
 
 //export AchievementManagerFetchUserAchievementsCallback
@@ -1569,7 +1702,7 @@ func AchievementManagerFetchUserAchievementsCallback(callbackData unsafe.Pointer
 	}
 	handle := runtimecgo.Handle(callbackData)
 	cb, ok := handle.Value().(func(int32))
-	if ok {
+	if ok && cb != nil {
 		cb(int32(result))
 	}
 	handle.Delete()
@@ -1705,16 +1838,19 @@ func LobbyManagerDisconnectLobbyCallback(callbackData unsafe.Pointer, result C.e
 	handle.Delete()
 }
 
-// ImageManagerFetchGo
+// ImageManagerFetchGo fetches an image and invokes goCallback with the result and a pointer
+// to the fetched handle. The handle is copied before dispatching, so it may point to Go stack memory.
 func ImageManagerFetchGo(manager unsafe.Pointer, handle unsafe.Pointer, refresh bool, goCallback func(result int32, handleResult unsafe.Pointer)) {
 	handleGo := runtimecgo.NewHandle(goCallback)
-	C.discord_image_manager_fetch(
-		(*C.struct_IDiscordImageManager)(manager),
-		*(*C.struct_DiscordImageHandle)(handle),
-		C.bool(refresh),
-		unsafe.Pointer(handleGo),
-		nil, // callback pointer is handled by Go trampoline
-	)
+	cHandle := *(*C.struct_DiscordImageHandle)(handle)
+	runOnDispatcher(func() {
+		C.discord_image_manager_fetch_trampoline(
+			(*C.struct_IDiscordImageManager)(manager),
+			cHandle,
+			C.bool(refresh),
+			unsafe.Pointer(handleGo),
+		)
+	})
 }
 
 //export ImageManagerFetchCallback
@@ -1724,7 +1860,7 @@ func ImageManagerFetchCallback(callbackData unsafe.Pointer, result C.enum_EDisco
 	}
 	handle := runtimecgo.Handle(callbackData)
 	cb, ok := handle.Value().(func(int32, unsafe.Pointer))
-	if ok {
+	if ok && cb != nil {
 		cb(int32(result), unsafe.Pointer(&handleResult))
 	}
 	handle.Delete()
@@ -1874,14 +2010,15 @@ func LobbyManagerDisconnectVoiceCallback(callbackData unsafe.Pointer, result C.e
 	handle.Delete()
 }
 
-// LobbyManagerConnectLobbyWithActivitySecretGo
+// LobbyManagerConnectLobbyWithActivitySecretGo connects to a lobby with an activity secret and
+// invokes goCallback with the result and a pointer to the C lobby, valid only during the callback.
+// It must be called on the dispatcher thread; the SDK copies activitySecret before returning.
 func LobbyManagerConnectLobbyWithActivitySecretGo(manager unsafe.Pointer, activitySecret unsafe.Pointer, goCallback func(result int32, lobby unsafe.Pointer)) {
 	handle := runtimecgo.NewHandle(goCallback)
-	C.discord_lobby_manager_connect_lobby_with_activity_secret(
+	C.discord_lobby_manager_connect_lobby_with_activity_secret_trampoline(
 		(*C.struct_IDiscordLobbyManager)(manager),
 		(*C.char)(activitySecret),
 		unsafe.Pointer(handle),
-		nil, // callback pointer is handled by Go trampoline
 	)
 }
 
@@ -1892,7 +2029,7 @@ func LobbyManagerConnectLobbyWithActivitySecretCallback(callbackData unsafe.Poin
 	}
 	handle := runtimecgo.Handle(callbackData)
 	cb, ok := handle.Value().(func(int32, unsafe.Pointer))
-	if ok {
+	if ok && cb != nil {
 		cb(int32(result), unsafe.Pointer(lobby))
 	}
 	handle.Delete()
@@ -2043,15 +2180,18 @@ func OverlayManagerOpenVoiceSettingsCallback(callbackData unsafe.Pointer, result
 	handle.Delete()
 }
 
-// VoiceManagerSetInputModeGo
+// VoiceManagerSetInputModeGo sets the voice input mode and invokes goCallback with the result.
+// The input mode is copied before dispatching, so it may point to Go stack memory.
 func VoiceManagerSetInputModeGo(manager unsafe.Pointer, inputMode unsafe.Pointer, goCallback func(result int32)) {
 	handle := runtimecgo.NewHandle(goCallback)
-	C.discord_voice_manager_set_input_mode(
-		(*C.struct_IDiscordVoiceManager)(manager),
-		*(*C.struct_DiscordInputMode)(inputMode),
-		unsafe.Pointer(handle),
-		nil, // callback pointer is handled by Go trampoline
-	)
+	cMode := *(*C.struct_DiscordInputMode)(inputMode)
+	runOnDispatcher(func() {
+		C.discord_voice_manager_set_input_mode_trampoline(
+			(*C.struct_IDiscordVoiceManager)(manager),
+			cMode,
+			unsafe.Pointer(handle),
+		)
+	})
 }
 
 //export VoiceManagerSetInputModeCallback
@@ -2061,8 +2201,178 @@ func VoiceManagerSetInputModeCallback(callbackData unsafe.Pointer, result C.enum
 	}
 	handle := runtimecgo.Handle(callbackData)
 	cb, ok := handle.Value().(func(int32))
-	if ok {
+	if ok && cb != nil {
 		cb(int32(result))
 	}
 	handle.Delete()
 }
+
+// eventHandlers returns the handlers stored in event_data by NewEventData
+func eventHandlers(eventData unsafe.Pointer) *EventHandlers {
+	if eventData == nil {
+		return nil
+	}
+	handlers, _ := runtimecgo.Handle(*(*C.uintptr_t)(eventData)).Value().(*EventHandlers)
+	return handlers
+}
+
+func eventBytes(data *C.uint8_t, dataLength C.uint32_t) []byte {
+	if data == nil || dataLength == 0 {
+		return nil
+	}
+	return C.GoBytes(unsafe.Pointer(data), C.int(dataLength))
+}
+
+//export CoreOnCurrentUserUpdate
+func CoreOnCurrentUserUpdate(eventData unsafe.Pointer) {
+	if h := eventHandlers(eventData); h != nil && h.OnCurrentUserUpdate != nil {
+		h.OnCurrentUserUpdate()
+	}
+}
+
+//export CoreOnActivityJoin
+func CoreOnActivityJoin(eventData unsafe.Pointer, secret *C.char) {
+	if h := eventHandlers(eventData); h != nil && h.OnActivityJoin != nil {
+		h.OnActivityJoin(C.GoString(secret))
+	}
+}
+
+//export CoreOnActivitySpectate
+func CoreOnActivitySpectate(eventData unsafe.Pointer, secret *C.char) {
+	if h := eventHandlers(eventData); h != nil && h.OnActivitySpectate != nil {
+		h.OnActivitySpectate(C.GoString(secret))
+	}
+}
+
+//export CoreOnActivityJoinRequest
+func CoreOnActivityJoinRequest(eventData unsafe.Pointer, user *C.struct_DiscordUser) {
+	if h := eventHandlers(eventData); h != nil && h.OnActivityJoinRequest != nil && user != nil {
+		h.OnActivityJoinRequest(unsafe.Pointer(user))
+	}
+}
+
+//export CoreOnActivityInvite
+func CoreOnActivityInvite(eventData unsafe.Pointer, actionType C.enum_EDiscordActivityActionType, user *C.struct_DiscordUser, activity *C.struct_DiscordActivity) {
+	if h := eventHandlers(eventData); h != nil && h.OnActivityInvite != nil && user != nil && activity != nil {
+		h.OnActivityInvite(int32(actionType), unsafe.Pointer(user), unsafe.Pointer(activity))
+	}
+}
+
+//export CoreOnRelationshipRefresh
+func CoreOnRelationshipRefresh(eventData unsafe.Pointer) {
+	if h := eventHandlers(eventData); h != nil && h.OnRelationshipRefresh != nil {
+		h.OnRelationshipRefresh()
+	}
+}
+
+//export CoreOnRelationshipUpdate
+func CoreOnRelationshipUpdate(eventData unsafe.Pointer, relationship *C.struct_DiscordRelationship) {
+	if h := eventHandlers(eventData); h != nil && h.OnRelationshipUpdate != nil && relationship != nil {
+		h.OnRelationshipUpdate(unsafe.Pointer(relationship))
+	}
+}
+
+//export CoreOnLobbyUpdate
+func CoreOnLobbyUpdate(eventData unsafe.Pointer, lobbyID C.int64_t) {
+	if h := eventHandlers(eventData); h != nil && h.OnLobbyUpdate != nil {
+		h.OnLobbyUpdate(int64(lobbyID))
+	}
+}
+
+//export CoreOnLobbyDelete
+func CoreOnLobbyDelete(eventData unsafe.Pointer, lobbyID C.int64_t, reason C.uint32_t) {
+	if h := eventHandlers(eventData); h != nil && h.OnLobbyDelete != nil {
+		h.OnLobbyDelete(int64(lobbyID), uint32(reason))
+	}
+}
+
+//export CoreOnMemberConnect
+func CoreOnMemberConnect(eventData unsafe.Pointer, lobbyID C.int64_t, userID C.int64_t) {
+	if h := eventHandlers(eventData); h != nil && h.OnMemberConnect != nil {
+		h.OnMemberConnect(int64(lobbyID), int64(userID))
+	}
+}
+
+//export CoreOnMemberUpdate
+func CoreOnMemberUpdate(eventData unsafe.Pointer, lobbyID C.int64_t, userID C.int64_t) {
+	if h := eventHandlers(eventData); h != nil && h.OnMemberUpdate != nil {
+		h.OnMemberUpdate(int64(lobbyID), int64(userID))
+	}
+}
+
+//export CoreOnMemberDisconnect
+func CoreOnMemberDisconnect(eventData unsafe.Pointer, lobbyID C.int64_t, userID C.int64_t) {
+	if h := eventHandlers(eventData); h != nil && h.OnMemberDisconnect != nil {
+		h.OnMemberDisconnect(int64(lobbyID), int64(userID))
+	}
+}
+
+//export CoreOnLobbyMessage
+func CoreOnLobbyMessage(eventData unsafe.Pointer, lobbyID C.int64_t, userID C.int64_t, data *C.uint8_t, dataLength C.uint32_t) {
+	if h := eventHandlers(eventData); h != nil && h.OnLobbyMessage != nil {
+		h.OnLobbyMessage(int64(lobbyID), int64(userID), eventBytes(data, dataLength))
+	}
+}
+
+//export CoreOnSpeaking
+func CoreOnSpeaking(eventData unsafe.Pointer, lobbyID C.int64_t, userID C.int64_t, speaking C.bool) {
+	if h := eventHandlers(eventData); h != nil && h.OnSpeaking != nil {
+		h.OnSpeaking(int64(lobbyID), int64(userID), bool(speaking))
+	}
+}
+
+//export CoreOnLobbyNetworkMessage
+func CoreOnLobbyNetworkMessage(eventData unsafe.Pointer, lobbyID C.int64_t, userID C.int64_t, channelID C.uint8_t, data *C.uint8_t, dataLength C.uint32_t) {
+	if h := eventHandlers(eventData); h != nil && h.OnLobbyNetworkMessage != nil {
+		h.OnLobbyNetworkMessage(int64(lobbyID), int64(userID), uint8(channelID), eventBytes(data, dataLength))
+	}
+}
+
+//export CoreOnNetworkMessage
+func CoreOnNetworkMessage(eventData unsafe.Pointer, peerID C.DiscordNetworkPeerId, channelID C.DiscordNetworkChannelId, data *C.uint8_t, dataLength C.uint32_t) {
+	if h := eventHandlers(eventData); h != nil && h.OnNetworkMessage != nil {
+		h.OnNetworkMessage(uint64(peerID), uint8(channelID), eventBytes(data, dataLength))
+	}
+}
+
+//export CoreOnRouteUpdate
+func CoreOnRouteUpdate(eventData unsafe.Pointer, routeData *C.char) {
+	if h := eventHandlers(eventData); h != nil && h.OnRouteUpdate != nil {
+		h.OnRouteUpdate(C.GoString(routeData))
+	}
+}
+
+//export CoreOnOverlayToggle
+func CoreOnOverlayToggle(eventData unsafe.Pointer, locked C.bool) {
+	if h := eventHandlers(eventData); h != nil && h.OnOverlayToggle != nil {
+		h.OnOverlayToggle(bool(locked))
+	}
+}
+
+//export CoreOnEntitlementCreate
+func CoreOnEntitlementCreate(eventData unsafe.Pointer, entitlement *C.struct_DiscordEntitlement) {
+	if h := eventHandlers(eventData); h != nil && h.OnEntitlementCreate != nil && entitlement != nil {
+		h.OnEntitlementCreate(unsafe.Pointer(entitlement))
+	}
+}
+
+//export CoreOnEntitlementDelete
+func CoreOnEntitlementDelete(eventData unsafe.Pointer, entitlement *C.struct_DiscordEntitlement) {
+	if h := eventHandlers(eventData); h != nil && h.OnEntitlementDelete != nil && entitlement != nil {
+		h.OnEntitlementDelete(unsafe.Pointer(entitlement))
+	}
+}
+
+//export CoreOnVoiceSettingsUpdate
+func CoreOnVoiceSettingsUpdate(eventData unsafe.Pointer) {
+	if h := eventHandlers(eventData); h != nil && h.OnVoiceSettingsUpdate != nil {
+		h.OnVoiceSettingsUpdate()
+	}
+}
+
+//export CoreOnUserAchievementUpdate
+func CoreOnUserAchievementUpdate(eventData unsafe.Pointer, userAchievement *C.struct_DiscordUserAchievement) {
+	if h := eventHandlers(eventData); h != nil && h.OnUserAchievementUpdate != nil && userAchievement != nil {
+		h.OnUserAchievementUpdate(unsafe.Pointer(userAchievement))
+	}
+}
@@ -246,6 +246,18 @@ void discord_storage_manager_write_async_trampoline(struct IDiscordStorageManage
     manager->write_async(manager, name, data, data_length, go_callback_data, c_storage_write_async_callback);
 }
 
+// Forward declaration for Go read async partial callback trampoline
+extern void go_storage_read_async_partial_callback_trampoline(void* go_callback_data, enum EDiscordResult result, uint8_t* data, uint32_t data_length);
+
+// C callback that forwards to Go trampoline for read async partial
+static void c_storage_read_async_partial_callback(void* go_callback_data, enum EDiscordResult result, uint8_t* data, uint32_t data_length) {
+    go_storage_read_async_partial_callback_trampoline(go_callback_data, result, data, data_length);
+}
+
+void discord_storage_manager_read_async_partial_trampoline(struct IDiscordStorageManager* manager, const char* name, uint64_t offset, uint64_t length, void* go_callback_data) {
+    manager->read_async_partial(manager, name, offset, length, go_callback_data, c_storage_read_async_partial_callback);
+}
+
 // Overlay manager wrappers
 void discord_overlay_manager_is_enabled(struct IDiscordOverlayManager* manager, bool* enabled) {
     manager->is_enabled(manager, enabled);
@@ -464,6 +476,18 @@ void discord_voice_manager_set_input_mode(struct IDiscordVoiceManager* manager,
     manager->set_input_mode(manager, input_mode, callback_data, callback);
 }
 
+// Forward declaration for Go set input mode callback
+extern void VoiceManagerSetInputModeCallback(void* callbackData, enum EDiscordResult result);
+
+// C callback that forwards to Go for setting the input mode
+static void c_voice_manager_set_input_mode_callback(void* go_callback_data, enum EDiscordResult result) {
+    VoiceManagerSetInputModeCallback(go_callback_data, result);
+}
+
+void discord_voice_manager_set_input_mode_trampoline(struct IDiscordVoiceManager* manager, struct DiscordInputMode input_mode, void* go_callback_data) {
+    manager->set_input_mode(manager, input_mode, go_callback_data, c_voice_manager_set_input_mode_callback);
+}
+
 enum EDiscordResult discord_voice_manager_is_self_mute(struct IDiscordVoiceManager* manager, bool* mute) {
     return manager->is_self_mute(manager, mute);
 }
@@ -505,6 +529,27 @@ void discord_achievement_manager_fetch_user_achievements(struct IDiscordAchievem
     manager->fetch_user_achievements(manager, callback_data, callback);
 }
 
+// Forward declarations for Go achievement callbacks
+extern void AchievementManagerSetUserAchievementCallback(void* callbackData, enum EDiscordResult result);
+extern void AchievementManagerFetchUserAchievementsCallback(void* callbackData, enum EDiscordResult result);
+
+// C callbacks that forward to Go for achievement set and fetch
+static void c_achievement_manager_set_user_achievement_callback(void* go_callback_data, enum EDiscordResult result) {
+    AchievementManagerSetUserAchievementCallback(go_callback_data, result);
+}
+
+static void c_achievement_manager_fetch_user_achievements_callback(void* go_callback_data, enum EDiscordResult result) {
+    AchievementManagerFetchUserAchievementsCallback(go_callback_data, result);
+}
+
+void discord_achievement_manager_set_user_achievement_trampoline(struct IDiscordAchievementManager* manager, DiscordSnowflake achievement_id, uint8_t percent_complete, void* go_callback_data) {
+    manager->set_user_achievement(manager, achievement_id, percent_complete, go_callback_data, c_achievement_manager_set_user_achievement_callback);
+}
+
+void discord_achievement_manager_fetch_user_achievements_trampoline(struct IDiscordAchievementManager* manager, void* go_callback_data) {
+    manager->fetch_user_achievements(manager, go_callback_data, c_achievement_manager_fetch_user_achievements_callback);
+}
+
 void discord_achievement_manager_count_user_achievements(struct IDiscordAchievementManager* manager, int32_t* count) {
     manager->count_user_achievements(manager, count);
 }
@@ -528,10 +573,34 @@ enum EDiscordResult discord_image_manager_get_data(struct IDiscordImageManager*
     return manager->get_data(manager, handle, data, data_length);
 } 
 
+// Forward declaration for Go image fetch callback
+extern void ImageManagerFetchCallback(void* callbackData, enum EDiscordResult result, struct DiscordImageHandle handleResult);
+
+// C callback that forwards to Go for image fetch
+static void c_image_manager_fetch_callback(void* go_callback_data, enum EDiscordResult result, struct DiscordImageHandle handle_result) {
+    ImageManagerFetchCallback(go_callback_data, result, handle_result);
+}
+
+void discord_image_manager_fetch_trampoline(struct IDiscordImageManager* manager, struct DiscordImageHandle handle, bool refresh, void* go_callback_data) {
+    manager->fetch(manager, handle, refresh, go_callback_data, c_image_manager_fetch_callback);
+}
+
 // Relationship manager wrappers
 void discord_relationship_manager_filter(struct IDiscordRelationshipManager* manager, void* filter_data, bool (*filter)(void* filter_data, struct DiscordRelationship* relationship)) {
     manager->filter(manager, filter_data, filter);
 }
+
+// Forward declaration for Go relationship filter trampoline
+extern bool go_relationship_filter_trampoline(void* go_filter_data, struct DiscordRelationship* relationship);
+
+// C filter that forwards to Go trampoline
+static bool c_relationship_filter(void* go_filter_data, struct DiscordRelationship* relationship) {
+    return go_relationship_filter_trampoline(go_filter_data, relationship);
+}
+
+void discord_relationship_manager_filter_trampoline(struct IDiscordRelationshipManager* manager, void* go_filter_data) {
+    manager->filter(manager, go_filter_data, c_relationship_filter);
+}
 enum EDiscordResult discord_relationship_manager_count(struct IDiscordRelationshipManager* manager, int32_t* count) {
     return manager->count(manager, count);
 }
@@ -547,6 +616,18 @@ void discord_lobby_manager_connect_lobby_with_activity_secret(struct IDiscordLob
     manager->connect_lobby_with_activity_secret(manager, activity_secret, callback_data, callback);
 }
 
+// Forward declaration for Go connect with activity secret callback
+extern void LobbyManagerConnectLobbyWithActivitySecretCallback(void* callbackData, enum EDiscordResult result, struct DiscordLobby* lobby);
+
+// C callback that forwards to Go for connecting with an activity secret
+static void c_lobby_manager_connect_lobby_with_activity_secret_callback(void* go_callback_data, enum EDiscordResult result, struct DiscordLobby* lobby) {
+    LobbyManagerConnectLobbyWithActivitySecretCallback(go_callback_data, result, lobby);
+}
+
+void discord_lobby_manager_connect_lobby_with_activity_secret_trampoline(struct IDiscordLobbyManager* manager, DiscordLobbySecret activity_secret, void* go_callback_data) {
+    manager->connect_lobby_with_activity_secret(manager, activity_secret, go_callback_data, c_lobby_manager_connect_lobby_with_activity_secret_callback);
+}
+
 enum EDiscordResult discord_lobby_manager_get_member_update_transaction(struct IDiscordLobbyManager* manager, DiscordLobbyId lobby_id, DiscordUserId user_id, struct IDiscordLobbyMemberTransaction** transaction) {
     return manager->get_member_update_transaction(manager, lobby_id, user_id, transaction);
 }
@@ -628,4 +709,180 @@ int64_t get_discord_entitlement_sku_id(struct DiscordEntitlement* ent) { return
 // Field accessors for DiscordFileStat
 void get_discord_file_stat_filename(struct DiscordFileStat* stat, char* buf, int bufsize) { strncpy(buf, stat->filename, bufsize); buf[bufsize-1] = '\0'; }
 uint64_t get_discord_file_stat_size(struct DiscordFileStat* stat) { return stat->size; }
-uint64_t get_discord_file_stat_last_modified(struct DiscordFileStat* stat) { return stat->last_modified; } 
\ No newline at end of file
+uint64_t get_discord_file_stat_last_modified(struct DiscordFileStat* stat) { return stat->last_modified; }
+
+// Forward declarations for Go event handlers
+extern void CoreOnCurrentUserUpdate(void* eventData);
+extern void CoreOnActivityJoin(void* eventData, char* secret);
+extern void CoreOnActivitySpectate(void* eventData, char* secret);
+extern void CoreOnActivityJoinRequest(void* eventData, struct DiscordUser* user);
+extern void CoreOnActivityInvite(void* eventData, enum EDiscordActivityActionType type, struct DiscordUser* user, struct DiscordActivity* activity);
+extern void CoreOnRelationshipRefresh(void* eventData);
+extern void CoreOnRelationshipUpdate(void* eventData, struct DiscordRelationship* relationship);
+extern void CoreOnLobbyUpdate(void* eventData, int64_t lobby_id);
+extern void CoreOnLobbyDelete(void* eventData, int64_t lobby_id, uint32_t reason);
+extern void CoreOnMemberConnect(void* eventData, int64_t lobby_id, int64_t user_id);
+extern void CoreOnMemberUpdate(void* eventData, int64_t lobby_id, int64_t user_id);
+extern void CoreOnMemberDisconnect(void* eventData, int64_t lobby_id, int64_t user_id);
+extern void CoreOnLobbyMessage(void* eventData, int64_t lobby_id, int64_t user_id, uint8_t* data, uint32_t data_length);
+extern void CoreOnSpeaking(void* eventData, int64_t lobby_id, int64_t user_id, bool speaking);
+extern void CoreOnLobbyNetworkMessage(void* eventData, int64_t lobby_id, int64_t user_id, uint8_t channel_id, uint8_t* data, uint32_t data_length);
+extern void CoreOnNetworkMessage(void* eventData, DiscordNetworkPeerId peer_id, DiscordNetworkChannelId channel_id, uint8_t* data, uint32_t data_length);
+extern void CoreOnRouteUpdate(void* eventData, char* route_data);
+extern void CoreOnOverlayToggle(void* eventData, bool locked);
+extern void CoreOnEntitlementCreate(void* eventData, struct DiscordEntitlement* entitlement);
+extern void CoreOnEntitlementDelete(void* eventData, struct DiscordEntitlement* entitlement);
+extern void CoreOnVoiceSettingsUpdate(void* eventData);
+extern void CoreOnUserAchievementUpdate(void* eventData, struct DiscordUserAchievement* user_achievement);
+
+// C event handlers that forward to Go; event_data identifies the Go handlers of the core
+static void DISCORD_CALLBACK c_on_current_user_update(void* event_data) {
+    CoreOnCurrentUserUpdate(event_data);
+}
+
+static void DISCORD_CALLBACK c_on_activity_join(void* event_data, const char* secret) {
+    CoreOnActivityJoin(event_data, (char*)secret);
+}
+
+static void DISCORD_CALLBACK c_on_activity_spectate(void* event_data, const char* secret) {
+    CoreOnActivitySpectate(event_data, (char*)secret);
+}
+
+static void DISCORD_CALLBACK c_on_activity_join_request(void* event_data, struct DiscordUser* user) {
+    CoreOnActivityJoinRequest(event_data, user);
+}
+
+static void DISCORD_CALLBACK c_on_activity_invite(void* event_data, enum EDiscordActivityActionType type, struct DiscordUser* user, struct DiscordActivity* activity) {
+    CoreOnActivityInvite(event_data, type, user, activity);
+}
+
+static void DISCORD_CALLBACK c_on_relationship_refresh(void* event_data) {
+    CoreOnRelationshipRefresh(event_data);
+}
+
+static void DISCORD_CALLBACK c_on_relationship_update(void* event_data, struct DiscordRelationship* relationship) {
+    CoreOnRelationshipUpdate(event_data, relationship);
+}
+
+static void DISCORD_CALLBACK c_on_lobby_update(void* event_data, int64_t lobby_id) {
+    CoreOnLobbyUpdate(event_data, lobby_id);
+}
+
+static void DISCORD_CALLBACK c_on_lobby_delete(void* event_data, int64_t lobby_id, uint32_t reason) {
+    CoreOnLobbyDelete(event_data, lobby_id, reason);
+}
+
+static void DISCORD_CALLBACK c_on_member_connect(void* event_data, int64_t lobby_id, int64_t user_id) {
+    CoreOnMemberConnect(event_data, lobby_id, user_id);
+}
+
+static void DISCORD_CALLBACK c_on_member_update(void* event_data, int64_t lobby_id, int64_t user_id) {
+    CoreOnMemberUpdate(event_data, lobby_id, user_id);
+}
+
+static void DISCORD_CALLBACK c_on_member_disconnect(void* event_data, int64_t lobby_id, int64_t user_id) {
+    CoreOnMemberDisconnect(event_data, lobby_id, user_id);
+}
+
+static void DISCORD_CALLBACK c_on_lobby_message(void* event_data, int64_t lobby_id, int64_t user_id, uint8_t* data, uint32_t data_length) {
+    CoreOnLobbyMessage(event_data, lobby_id, user_id, data, data_length);
+}
+
+static void DISCORD_CALLBACK c_on_speaking(void* event_data, int64_t lobby_id, int64_t user_id, bool speaking) {
+    CoreOnSpeaking(event_data, lobby_id, user_id, speaking);
+}
+
+static void DISCORD_CALLBACK c_on_lobby_network_message(void* event_data, int64_t lobby_id, int64_t user_id, uint8_t channel_id, uint8_t* data, uint32_t data_length) {
+    CoreOnLobbyNetworkMessage(event_data, lobby_id, user_id, channel_id, data, data_length);
+}
+
+static void DISCORD_CALLBACK c_on_network_message(void* event_data, DiscordNetworkPeerId peer_id, DiscordNetworkChannelId channel_id, uint8_t* data, uint32_t data_length) {
+    CoreOnNetworkMessage(event_data, peer_id, channel_id, data, data_length);
+}
+
+static void DISCORD_CALLBACK c_on_route_update(void* event_data, const char* route_data) {
+    CoreOnRouteUpdate(event_data, (char*)route_data);
+}
+
+static void DISCORD_CALLBACK c_on_overlay_toggle(void* event_data, bool locked) {
+    CoreOnOverlayToggle(event_data, locked);
+}
+
+static void DISCORD_CALLBACK c_on_entitlement_create(void* event_data, struct DiscordEntitlement* entitlement) {
+    CoreOnEntitlementCreate(event_data, entitlement);
+}
+
+static void DISCORD_CALLBACK c_on_entitlement_delete(void* event_data, struct DiscordEntitlement* entitlement) {
+    CoreOnEntitlementDelete(event_data, entitlement);
+}
+
+static void DISCORD_CALLBACK c_on_voice_settings_update(void* event_data) {
+    CoreOnVoiceSettingsUpdate(event_data);
+}
+
+static void DISCORD_CALLBACK c_on_user_achievement_update(void* event_data, struct DiscordUserAchievement* user_achievement) {
+    CoreOnUserAchievementUpdate(event_data, user_achievement);
+}
+
+// Event tables shared by every core; the SDK only reads them
+static struct IDiscordUserEvents discord_user_events = {
+    c_on_current_user_update,
+};
+
+static struct IDiscordActivityEvents discord_activity_events = {
+    c_on_activity_join,
+    c_on_activity_spectate,
+    c_on_activity_join_request,
+    c_on_activity_invite,
+};
+
+static struct IDiscordRelationshipEvents discord_relationship_events = {
+    c_on_relationship_refresh,
+    c_on_relationship_update,
+};
+
+static struct IDiscordLobbyEvents discord_lobby_events = {
+    c_on_lobby_update,
+    c_on_lobby_delete,
+    c_on_member_connect,
+    c_on_member_update,
+    c_on_member_disconnect,
+    c_on_lobby_message,
+    c_on_speaking,
+    c_on_lobby_network_message,
+};
+
+static struct IDiscordNetworkEvents discord_network_events = {
+    c_on_network_message,
+    c_on_route_update,
+};
+
+static struct IDiscordOverlayEvents discord_overlay_events = {
+    c_on_overlay_toggle,
+};
+
+static struct IDiscordStoreEvents discord_store_events = {
+    c_on_entitlement_create,
+    c_on_entitlement_delete,
+};
+
+static struct IDiscordVoiceEvents discord_voice_events = {
+    c_on_voice_settings_update,
+};
+
+static struct IDiscordAchievementEvents discord_achievement_events = {
+    c_on_user_achievement_update,
+};
+
+void discord_create_params_set_events(struct DiscordCreateParams* params, void* event_data) {
+    params->event_data = event_data;
+    params->user_events = &discord_user_events;
+    params->activity_events = &discord_activity_events;
+    params->relationship_events = &discord_relationship_events;
+    params->lobby_events = &discord_lobby_events;
+    params->network_events = &discord_network_events;
+    params->overlay_events = &discord_overlay_events;
+    params->store_events = &discord_store_events;
+    params->voice_events = &discord_voice_events;
+    params->achievement_events = &discord_achievement_events;
+}
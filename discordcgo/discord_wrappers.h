@@ -90,6 +90,11 @@ typedef void (*go_storage_write_async_callback_t)(void* go_callback_data, enum E
 
 // Update the write_async wrapper to accept only go_callback_data
 void discord_storage_manager_write_async_trampoline(struct IDiscordStorageManager* manager, const char* name, uint8_t* data, uint32_t data_length, void* go_callback_data);
+// Callback trampoline for Go read async partial
+typedef void (*go_storage_read_async_partial_callback_t)(void* go_callback_data, enum EDiscordResult result, uint8_t* data, uint32_t data_length);
+
+// Ranged read_async_partial wrapper that accepts only go_callback_data
+void discord_storage_manager_read_async_partial_trampoline(struct IDiscordStorageManager* manager, const char* name, uint64_t offset, uint64_t length, void* go_callback_data);
 enum EDiscordResult discord_storage_manager_delete_(struct IDiscordStorageManager* manager, const char* name);
 enum EDiscordResult discord_storage_manager_exists(struct IDiscordStorageManager* manager, const char* name, bool* exists);
 void discord_storage_manager_count(struct IDiscordStorageManager* manager, int32_t* count);
@@ -117,6 +122,8 @@ void discord_store_manager_start_purchase(struct IDiscordStoreManager* manager,
 // Voice manager wrappers
 enum EDiscordResult discord_voice_manager_get_input_mode(struct IDiscordVoiceManager* manager, struct DiscordInputMode* input_mode);
 void discord_voice_manager_set_input_mode(struct IDiscordVoiceManager* manager, struct DiscordInputMode input_mode, void* callback_data, void (*callback)(void* callback_data, enum EDiscordResult result));
+// SetInputMode wrapper that accepts only go_callback_data and forwards completion to VoiceManagerSetInputModeCallback
+void discord_voice_manager_set_input_mode_trampoline(struct IDiscordVoiceManager* manager, struct DiscordInputMode input_mode, void* go_callback_data);
 enum EDiscordResult discord_voice_manager_is_self_mute(struct IDiscordVoiceManager* manager, bool* mute);
 enum EDiscordResult discord_voice_manager_set_self_mute(struct IDiscordVoiceManager* manager, bool mute);
 enum EDiscordResult discord_voice_manager_is_self_deaf(struct IDiscordVoiceManager* manager, bool* deaf);
@@ -129,6 +136,9 @@ enum EDiscordResult discord_voice_manager_set_local_volume(struct IDiscordVoiceM
 // Achievement manager wrappers
 void discord_achievement_manager_set_user_achievement(struct IDiscordAchievementManager* manager, DiscordSnowflake achievement_id, uint8_t percent_complete, void* callback_data, void (*callback)(void* callback_data, enum EDiscordResult result));
 void discord_achievement_manager_fetch_user_achievements(struct IDiscordAchievementManager* manager, void* callback_data, void (*callback)(void* callback_data, enum EDiscordResult result));
+// Set and fetch wrappers that accept only go_callback_data and forward completion to the exported Go callbacks
+void discord_achievement_manager_set_user_achievement_trampoline(struct IDiscordAchievementManager* manager, DiscordSnowflake achievement_id, uint8_t percent_complete, void* go_callback_data);
+void discord_achievement_manager_fetch_user_achievements_trampoline(struct IDiscordAchievementManager* manager, void* go_callback_data);
 void discord_achievement_manager_count_user_achievements(struct IDiscordAchievementManager* manager, int32_t* count);
 enum EDiscordResult discord_achievement_manager_get_user_achievement(struct IDiscordAchievementManager* manager, DiscordSnowflake user_achievement_id, struct DiscordUserAchievement* user_achievement);
 enum EDiscordResult discord_achievement_manager_get_user_achievement_at(struct IDiscordAchievementManager* manager, int32_t index, struct DiscordUserAchievement* user_achievement);
@@ -150,6 +160,8 @@ bool discord_overlay_manager_is_point_inside_click_zone(struct IDiscordOverlayMa
 
 // Additional missing lobby manager wrappers
 void discord_lobby_manager_connect_lobby_with_activity_secret(struct IDiscordLobbyManager* manager, DiscordLobbySecret activity_secret, void* callback_data, void (*callback)(void* callback_data, enum EDiscordResult result, struct DiscordLobby* lobby));
+// Connect wrapper that accepts only go_callback_data and forwards completion to LobbyManagerConnectLobbyWithActivitySecretCallback
+void discord_lobby_manager_connect_lobby_with_activity_secret_trampoline(struct IDiscordLobbyManager* manager, DiscordLobbySecret activity_secret, void* go_callback_data);
 enum EDiscordResult discord_lobby_manager_get_member_update_transaction(struct IDiscordLobbyManager* manager, DiscordLobbyId lobby_id, DiscordUserId user_id, struct IDiscordLobbyMemberTransaction** transaction);
 enum EDiscordResult discord_lobby_manager_get_lobby_metadata_value(struct IDiscordLobbyManager* manager, DiscordLobbyId lobby_id, const char* key, char* value);
 enum EDiscordResult discord_lobby_manager_get_lobby_metadata_key(struct IDiscordLobbyManager* manager, DiscordLobbyId lobby_id, int32_t index, char* key);
@@ -179,8 +191,12 @@ enum EDiscordResult discord_lobby_manager_get_lobby_activity_secret(struct IDisc
 void discord_image_manager_fetch(struct IDiscordImageManager* manager, struct DiscordImageHandle handle, bool refresh, void* callback_data, void (*callback)(void* callback_data, enum EDiscordResult result, struct DiscordImageHandle handle_result));
 enum EDiscordResult discord_image_manager_get_dimensions(struct IDiscordImageManager* manager, struct DiscordImageHandle handle, struct DiscordImageDimensions* dimensions);
 enum EDiscordResult discord_image_manager_get_data(struct IDiscordImageManager* manager, struct DiscordImageHandle handle, uint8_t* data, uint32_t data_length);
+// Fetch wrapper that accepts only go_callback_data and forwards completion to ImageManagerFetchCallback
+void discord_image_manager_fetch_trampoline(struct IDiscordImageManager* manager, struct DiscordImageHandle handle, bool refresh, void* go_callback_data);
 // Relationship manager wrappers
 void discord_relationship_manager_filter(struct IDiscordRelationshipManager* manager, void* filter_data, bool (*filter)(void* filter_data, struct DiscordRelationship* relationship));
+// Filter wrapper that accepts only go_filter_data and forwards each relationship to go_relationship_filter_trampoline
+void discord_relationship_manager_filter_trampoline(struct IDiscordRelationshipManager* manager, void* go_filter_data);
 enum EDiscordResult discord_relationship_manager_count(struct IDiscordRelationshipManager* manager, int32_t* count);
 enum EDiscordResult discord_relationship_manager_get(struct IDiscordRelationshipManager* manager, DiscordUserId user_id, struct DiscordRelationship* relationship);
 enum EDiscordResult discord_relationship_manager_get_at(struct IDiscordRelationshipManager* manager, uint32_t index, struct DiscordRelationship* relationship);
@@ -198,4 +214,6 @@ int64_t get_discord_entitlement_sku_id(struct DiscordEntitlement* ent);
 void get_discord_file_stat_filename(struct DiscordFileStat* stat, char* buf, int bufsize);
 uint64_t get_discord_file_stat_size(struct DiscordFileStat* stat);
 uint64_t get_discord_file_stat_last_modified(struct DiscordFileStat* stat);
+// Event tables
+void discord_create_params_set_events(struct DiscordCreateParams* params, void* event_data);
 #endif 
\ No newline at end of file
@@ -0,0 +1,242 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// EntitlementChangeType describes how an entitlement changed
+type EntitlementChangeType int
+
+const (
+	// EntitlementAdded is emitted when an entitlement is granted
+	EntitlementAdded EntitlementChangeType = iota
+	// EntitlementRemoved is emitted when an entitlement is revoked
+	EntitlementRemoved
+)
+
+// String returns a string representation of the EntitlementChangeType
+func (t EntitlementChangeType) String() string {
+	switch t {
+	case EntitlementAdded:
+		return "EntitlementAdded"
+	case EntitlementRemoved:
+		return "EntitlementRemoved"
+	default:
+		return fmt.Sprintf("EntitlementChangeType(%d)", int(t))
+	}
+}
+
+// EntitlementChange represents a single change to the set of entitlements
+type EntitlementChange struct {
+	Type        EntitlementChangeType
+	Entitlement core.Entitlement
+}
+
+// EntitlementWatcher keeps an in-memory set of entitlements in sync with the
+// store's OnEntitlementCreate and OnEntitlementDelete events.
+//
+// Queries such as Has are answered from the cached set and never call into the SDK.
+type EntitlementWatcher struct {
+	store       *StoreClient
+	unsubscribe func()
+
+	mu           sync.RWMutex
+	entitlements map[int64]core.Entitlement // keyed by entitlement ID
+	skus         map[int64]int              // number of entitlements per SKU ID
+	changes      broadcaster[EntitlementChange]
+}
+
+// WatchEntitlements fetches the current entitlements and returns a watcher that
+// keeps them up to date as store events arrive.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+//	defer cancel()
+//	watcher, err := client.Store().WatchEntitlements(ctx)
+//	if err != nil {
+//	    log.Fatalf("failed to watch entitlements: %v", err)
+//	}
+//	defer watcher.Close()
+//	if watcher.Has(dlcSkuID) {
+//	    unlockDLC()
+//	}
+//
+// Returns an error if the context is cancelled, deadline exceeded, or the initial fetch fails.
+func (sc *StoreClient) WatchEntitlements(ctx context.Context) (*EntitlementWatcher, error) {
+	if sc.manager == nil {
+		return nil, fmt.Errorf("store manager not available")
+	}
+
+	w := newEntitlementWatcher(sc)
+	// Subscribe before fetching so entitlements granted during the fetch are not missed
+	if sc.core != nil {
+		w.listen(sc.core)
+	}
+	if err := w.Refresh(ctx); err != nil {
+		w.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func newEntitlementWatcher(store *StoreClient) *EntitlementWatcher {
+	return &EntitlementWatcher{
+		store:        store,
+		entitlements: make(map[int64]core.Entitlement),
+		skus:         make(map[int64]int),
+	}
+}
+
+// listen subscribes the watcher to the store events of source until Close
+func (w *EntitlementWatcher) listen(source eventSource) {
+	w.unsubscribe = source.AddStoreEvents(&core.StoreEvents{
+		OnEntitlementCreate: w.handleCreate,
+		OnEntitlementDelete: w.handleDelete,
+	})
+}
+
+// Refresh re-fetches all entitlements from the store and emits changes for any
+// differences with the cached set.
+func (w *EntitlementWatcher) Refresh(ctx context.Context) error {
+	ents, err := w.store.FetchEntitlementsWithContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	fresh := make(map[int64]core.Entitlement, len(ents))
+	for _, ent := range ents {
+		fresh[ent.ID] = ent
+	}
+
+	w.mu.Lock()
+	var changes []EntitlementChange
+	for id, ent := range w.entitlements {
+		if _, ok := fresh[id]; !ok {
+			w.removeLocked(ent)
+			changes = append(changes, EntitlementChange{Type: EntitlementRemoved, Entitlement: ent})
+		}
+	}
+	for id, ent := range fresh {
+		if _, ok := w.entitlements[id]; !ok {
+			w.addLocked(ent)
+			changes = append(changes, EntitlementChange{Type: EntitlementAdded, Entitlement: ent})
+		}
+	}
+	w.mu.Unlock()
+
+	for _, change := range changes {
+		w.emit(change)
+	}
+	return nil
+}
+
+// Has reports whether the current user holds at least one entitlement for skuID
+func (w *EntitlementWatcher) Has(skuID int64) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.skus[skuID] > 0
+}
+
+// HasType reports whether the current user holds at least one entitlement of the given type
+func (w *EntitlementWatcher) HasType(entitlementType core.EntitlementType) bool {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	for _, ent := range w.entitlements {
+		if ent.Type == entitlementType {
+			return true
+		}
+	}
+	return false
+}
+
+// Entitlements returns a snapshot of the cached entitlements
+func (w *EntitlementWatcher) Entitlements() []core.Entitlement {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	ents := make([]core.Entitlement, 0, len(w.entitlements))
+	for _, ent := range w.entitlements {
+		ents = append(ents, ent)
+	}
+	return ents
+}
+
+// Changes returns a channel that receives every entitlement change from now on.
+// Each call returns a new channel; all channels are closed by Close.
+//
+// Example usage:
+//
+//	go func() {
+//	    for change := range watcher.Changes() {
+//	        if change.Type == discord.EntitlementAdded {
+//	            fmt.Printf("Unlocked SKU %d\n", change.Entitlement.SkuID)
+//	        }
+//	    }
+//	}()
+func (w *EntitlementWatcher) Changes() <-chan EntitlementChange {
+	return w.changes.subscribe(8)
+}
+
+// Close stops delivering changes and closes all channels returned by Changes.
+// Other subscribers to the store events are not affected.
+func (w *EntitlementWatcher) Close() {
+	if !w.changes.close() {
+		return
+	}
+	if w.unsubscribe != nil {
+		w.unsubscribe()
+	}
+}
+
+func (w *EntitlementWatcher) handleCreate(ent *core.Entitlement) {
+	if ent == nil {
+		return
+	}
+	w.mu.Lock()
+	_, exists := w.entitlements[ent.ID]
+	if !exists {
+		w.addLocked(*ent)
+	}
+	w.mu.Unlock()
+	if !exists {
+		w.emit(EntitlementChange{Type: EntitlementAdded, Entitlement: *ent})
+	}
+}
+
+func (w *EntitlementWatcher) handleDelete(ent *core.Entitlement) {
+	if ent == nil {
+		return
+	}
+	w.mu.Lock()
+	cached, exists := w.entitlements[ent.ID]
+	if exists {
+		w.removeLocked(cached)
+	}
+	w.mu.Unlock()
+	if exists {
+		w.emit(EntitlementChange{Type: EntitlementRemoved, Entitlement: cached})
+	}
+}
+
+func (w *EntitlementWatcher) addLocked(ent core.Entitlement) {
+	w.entitlements[ent.ID] = ent
+	w.skus[ent.SkuID]++
+}
+
+func (w *EntitlementWatcher) removeLocked(ent core.Entitlement) {
+	delete(w.entitlements, ent.ID)
+	if w.skus[ent.SkuID] <= 1 {
+		delete(w.skus, ent.SkuID)
+	} else {
+		w.skus[ent.SkuID]--
+	}
+}
+
+// emit delivers a change to every subscriber; see broadcaster for why it never blocks
+func (w *EntitlementWatcher) emit(change EntitlementChange) {
+	w.changes.emit(change)
+}
@@ -0,0 +1,55 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleStoreClient_WatchEntitlements demonstrates how to keep entitlements in sync and react to new purchases.
+// This example is for documentation only and requires a real, initialized StoreClient.
+func ExampleStoreClient_WatchEntitlements() {
+	var storeClient *StoreClient // Assume this is properly initialized
+	var dlcSkuID int64           // Assume this is properly set
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	watcher, err := storeClient.WatchEntitlements(ctx)
+	if err != nil {
+		log.Fatalf("failed to watch entitlements: %v", err)
+	}
+	defer watcher.Close()
+
+	log.Printf("Owns DLC: %v", watcher.Has(dlcSkuID))
+	go func() {
+		for change := range watcher.Changes() {
+			log.Printf("%v: SKU %d", change.Type, change.Entitlement.SkuID)
+		}
+	}()
+	// No Output: (documentation only)
+}
+
+// ExampleEntitlementWatcher_Close demonstrates that closing a watcher only removes its own store event handlers.
+func ExampleEntitlementWatcher_Close() {
+	events := &testEvents{}
+	first := newEntitlementWatcher(&StoreClient{})
+	first.listen(events)
+	second := newEntitlementWatcher(&StoreClient{})
+	second.listen(events)
+	changes := second.Changes()
+
+	first.Close()
+	events.entitlementCreate(core.Entitlement{ID: 1, SkuID: 42})
+	fmt.Println(first.Has(42), second.Has(42), (<-changes).Type)
+
+	events.entitlementDelete(core.Entitlement{ID: 1, SkuID: 42})
+	fmt.Println(second.Has(42), (<-changes).Type)
+	second.Close()
+	// Output:
+	// false true EntitlementAdded
+	// false EntitlementRemoved
+}
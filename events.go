@@ -0,0 +1,69 @@
+package discord
+
+import (
+	"sync"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// eventSource is the part of *core.Core that watchers subscribe to SDK events through.
+// Each Add method returns a function that removes only that subscription.
+type eventSource interface {
+	AddStoreEvents(events *core.StoreEvents) func()
+	AddRelationshipEvents(events *core.RelationshipEvents) func()
+	AddVoiceEvents(events *core.VoiceEvents) func()
+	AddLobbyEvents(events *core.LobbyEvents) func()
+	AddOverlayEvents(events *core.OverlayEvents) func()
+	AddAchievementEvents(events *core.AchievementEvents) func()
+}
+
+// broadcaster fans values out to every channel returned by subscribe.
+//
+// Values are raised from the SDK callback loop, so emit never blocks: a subscriber
+// that is not keeping up misses the value instead of stalling the loop.
+type broadcaster[T any] struct {
+	mu          sync.Mutex
+	subscribers []chan T
+	closed      bool
+}
+
+// subscribe returns a new channel with the given buffer size. After close it
+// returns an already closed channel.
+func (b *broadcaster[T]) subscribe(buffer int) <-chan T {
+	ch := make(chan T, buffer)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(ch)
+		return ch
+	}
+	b.subscribers = append(b.subscribers, ch)
+	return ch
+}
+
+// emit delivers v to every subscriber that has room for it
+func (b *broadcaster[T]) emit(v T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- v:
+		default:
+		}
+	}
+}
+
+// close closes every subscriber channel. It reports false if the broadcaster was already closed.
+func (b *broadcaster[T]) close() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return false
+	}
+	b.closed = true
+	for _, ch := range b.subscribers {
+		close(ch)
+	}
+	b.subscribers = nil
+	return true
+}
@@ -0,0 +1,151 @@
+package discord
+
+import (
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// testEvents stands in for *core.Core in examples: it raises events on the
+// handlers subscribed through its Add methods, the way the core's event
+// multiplexer does
+type testEvents struct {
+	store         subscriptions[core.StoreEvents]
+	relationships subscriptions[core.RelationshipEvents]
+	voice         subscriptions[core.VoiceEvents]
+	lobby         subscriptions[core.LobbyEvents]
+	overlay       subscriptions[core.OverlayEvents]
+	achievement   subscriptions[core.AchievementEvents]
+}
+
+type subscriptions[T any] struct {
+	next     int
+	handlers []subscription[T]
+}
+
+type subscription[T any] struct {
+	id     int
+	events *T
+}
+
+func (s *subscriptions[T]) add(events *T) func() {
+	s.next++
+	id := s.next
+	s.handlers = append(s.handlers, subscription[T]{id: id, events: events})
+	return func() {
+		for i, h := range s.handlers {
+			if h.id == id {
+				s.handlers = append(s.handlers[:i:i], s.handlers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+func (s *subscriptions[T]) each(fn func(events *T)) {
+	for _, h := range append([]subscription[T](nil), s.handlers...) {
+		fn(h.events)
+	}
+}
+
+func (e *testEvents) AddStoreEvents(events *core.StoreEvents) func() {
+	return e.store.add(events)
+}
+
+func (e *testEvents) entitlementCreate(ent core.Entitlement) {
+	e.store.each(func(events *core.StoreEvents) {
+		if events.OnEntitlementCreate != nil {
+			events.OnEntitlementCreate(&ent)
+		}
+	})
+}
+
+func (e *testEvents) entitlementDelete(ent core.Entitlement) {
+	e.store.each(func(events *core.StoreEvents) {
+		if events.OnEntitlementDelete != nil {
+			events.OnEntitlementDelete(&ent)
+		}
+	})
+}
+
+func (e *testEvents) AddRelationshipEvents(events *core.RelationshipEvents) func() {
+	return e.relationships.add(events)
+}
+
+func (e *testEvents) relationshipRefresh() {
+	e.relationships.each(func(events *core.RelationshipEvents) {
+		if events.OnRefresh != nil {
+			events.OnRefresh()
+		}
+	})
+}
+
+func (e *testEvents) relationshipUpdate(rel core.Relationship) {
+	e.relationships.each(func(events *core.RelationshipEvents) {
+		if events.OnRelationshipUpdate != nil {
+			events.OnRelationshipUpdate(&rel)
+		}
+	})
+}
+
+func (e *testEvents) AddVoiceEvents(events *core.VoiceEvents) func() {
+	return e.voice.add(events)
+}
+
+func (e *testEvents) voiceSettingsUpdate() {
+	e.voice.each(func(events *core.VoiceEvents) {
+		if events.OnSettingsUpdate != nil {
+			events.OnSettingsUpdate()
+		}
+	})
+}
+
+func (e *testEvents) AddLobbyEvents(events *core.LobbyEvents) func() {
+	return e.lobby.add(events)
+}
+
+func (e *testEvents) memberConnect(lobbyID, userID int64) {
+	e.lobby.each(func(events *core.LobbyEvents) {
+		if events.OnMemberConnect != nil {
+			events.OnMemberConnect(lobbyID, userID)
+		}
+	})
+}
+
+func (e *testEvents) speaking(lobbyID, userID int64, speaking bool) {
+	e.lobby.each(func(events *core.LobbyEvents) {
+		if events.OnSpeaking != nil {
+			events.OnSpeaking(lobbyID, userID, speaking)
+		}
+	})
+}
+
+func (e *testEvents) memberDisconnect(lobbyID, userID int64) {
+	e.lobby.each(func(events *core.LobbyEvents) {
+		if events.OnMemberDisconnect != nil {
+			events.OnMemberDisconnect(lobbyID, userID)
+		}
+	})
+}
+
+func (e *testEvents) AddOverlayEvents(events *core.OverlayEvents) func() {
+	return e.overlay.add(events)
+}
+
+func (e *testEvents) overlayToggle(locked bool) {
+	e.overlay.each(func(events *core.OverlayEvents) {
+		if events.OnToggle != nil {
+			events.OnToggle(locked)
+		}
+	})
+}
+
+func (e *testEvents) AddAchievementEvents(events *core.AchievementEvents) func() {
+	return e.achievement.add(events)
+}
+
+func (e *testEvents) userAchievementUpdate(ach core.UserAchievement) {
+	e.achievement.each(func(events *core.AchievementEvents) {
+		if events.OnUserAchievementUpdate != nil {
+			events.OnUserAchievementUpdate(&ach)
+		}
+	})
+}
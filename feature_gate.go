@@ -0,0 +1,166 @@
+package discord
+
+import (
+	"sync"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// FeatureRule declares which entitlements enable a feature.
+// A feature is enabled when the user holds an entitlement for any of the SKU IDs
+// or any entitlement of one of the entitlement types.
+type FeatureRule struct {
+	SkuIDs           []int64
+	EntitlementTypes []core.EntitlementType
+}
+
+// FeatureGate answers whether entitlement-gated features are enabled and
+// notifies registered callbacks when a feature becomes enabled or disabled.
+//
+// It is backed by an EntitlementWatcher, so queries never call into the SDK.
+type FeatureGate struct {
+	watcher *EntitlementWatcher
+
+	mu        sync.RWMutex
+	rules     map[string]FeatureRule
+	enabled   map[string]bool // last state reported to callbacks
+	callbacks []func(feature string, enabled bool)
+
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewFeatureGate creates a feature gate that re-evaluates its features every
+// time the watcher reports an entitlement change.
+//
+// Example usage:
+//
+//	gate := discord.NewFeatureGate(watcher)
+//	defer gate.Close()
+//	gate.Register("soundtrack", discord.FeatureRule{SkuIDs: []int64{soundtrackSkuID}})
+//	gate.OnChange(func(feature string, enabled bool) {
+//	    fmt.Printf("%s enabled=%v\n", feature, enabled)
+//	})
+//	if gate.IsEnabled("soundtrack") {
+//	    playSoundtrack()
+//	}
+func NewFeatureGate(watcher *EntitlementWatcher) *FeatureGate {
+	g := &FeatureGate{
+		watcher: watcher,
+		rules:   make(map[string]FeatureRule),
+		enabled: make(map[string]bool),
+		stop:    make(chan struct{}),
+	}
+	changes := watcher.Changes()
+	go func() {
+		for {
+			select {
+			case _, ok := <-changes:
+				if !ok {
+					return
+				}
+				g.reevaluate()
+			case <-g.stop:
+				return
+			}
+		}
+	}()
+	return g
+}
+
+// Register adds or replaces the rule for a feature
+func (g *FeatureGate) Register(feature string, rule FeatureRule) {
+	g.mu.Lock()
+	g.rules[feature] = rule
+	g.enabled[feature] = g.evaluate(rule)
+	g.mu.Unlock()
+}
+
+// Unregister removes a feature. Unknown features are reported as disabled.
+func (g *FeatureGate) Unregister(feature string) {
+	g.mu.Lock()
+	delete(g.rules, feature)
+	delete(g.enabled, feature)
+	g.mu.Unlock()
+}
+
+// IsEnabled reports whether the user currently holds an entitlement that enables the feature
+func (g *FeatureGate) IsEnabled(feature string) bool {
+	g.mu.RLock()
+	rule, ok := g.rules[feature]
+	g.mu.RUnlock()
+	if !ok {
+		return false
+	}
+	return g.evaluate(rule)
+}
+
+// Features returns the enabled state of every registered feature
+func (g *FeatureGate) Features() map[string]bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	features := make(map[string]bool, len(g.rules))
+	for feature, rule := range g.rules {
+		features[feature] = g.evaluate(rule)
+	}
+	return features
+}
+
+// OnChange registers a callback invoked whenever a registered feature changes
+// between enabled and disabled. Callbacks run on the gate's event goroutine.
+func (g *FeatureGate) OnChange(callback func(feature string, enabled bool)) {
+	if callback == nil {
+		return
+	}
+	g.mu.Lock()
+	g.callbacks = append(g.callbacks, callback)
+	g.mu.Unlock()
+}
+
+// Close stops listening for entitlement changes. The underlying watcher is not closed.
+func (g *FeatureGate) Close() {
+	g.closeOnce.Do(func() {
+		close(g.stop)
+	})
+}
+
+func (g *FeatureGate) evaluate(rule FeatureRule) bool {
+	for _, skuID := range rule.SkuIDs {
+		if g.watcher.Has(skuID) {
+			return true
+		}
+	}
+	for _, entitlementType := range rule.EntitlementTypes {
+		if g.watcher.HasType(entitlementType) {
+			return true
+		}
+	}
+	return false
+}
+
+// reevaluate recomputes every feature and invokes callbacks for those that flipped
+func (g *FeatureGate) reevaluate() {
+	type flip struct {
+		feature string
+		enabled bool
+	}
+
+	g.mu.Lock()
+	var flips []flip
+	for feature, rule := range g.rules {
+		enabled := g.evaluate(rule)
+		if enabled != g.enabled[feature] {
+			g.enabled[feature] = enabled
+			flips = append(flips, flip{feature: feature, enabled: enabled})
+		}
+	}
+	callbacks := make([]func(feature string, enabled bool), len(g.callbacks))
+	copy(callbacks, g.callbacks)
+	g.mu.Unlock()
+
+	for _, f := range flips {
+		for _, callback := range callbacks {
+			callback(f.feature, f.enabled)
+		}
+	}
+}
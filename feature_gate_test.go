@@ -0,0 +1,53 @@
+package discord
+
+import (
+	"fmt"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleFeatureGate demonstrates how features follow the entitlements tracked by an EntitlementWatcher.
+func ExampleFeatureGate() {
+	events := &testEvents{}
+	watcher := newEntitlementWatcher(&StoreClient{})
+	watcher.listen(events)
+	defer watcher.Close()
+
+	gate := NewFeatureGate(watcher)
+	defer gate.Close()
+	gate.Register("soundtrack", FeatureRule{SkuIDs: []int64{42}})
+	gate.Register("premium-skins", FeatureRule{EntitlementTypes: []core.EntitlementType{core.EntitlementTypePremiumSubscription}})
+
+	fmt.Println(gate.IsEnabled("soundtrack"), gate.IsEnabled("premium-skins"))
+
+	events.entitlementCreate(core.Entitlement{ID: 1, Type: core.EntitlementTypePurchase, SkuID: 42})
+	fmt.Println(gate.IsEnabled("soundtrack"), gate.IsEnabled("premium-skins"))
+	// Output:
+	// false false
+	// true false
+}
+
+// ExampleFeatureGate_OnChange demonstrates callbacks following store events as they arrive.
+func ExampleFeatureGate_OnChange() {
+	events := &testEvents{}
+	watcher := newEntitlementWatcher(&StoreClient{})
+	watcher.listen(events)
+	defer watcher.Close()
+
+	gate := NewFeatureGate(watcher)
+	defer gate.Close()
+	gate.Register("soundtrack", FeatureRule{SkuIDs: []int64{42}})
+
+	flips := make(chan string, 2)
+	gate.OnChange(func(feature string, enabled bool) {
+		flips <- fmt.Sprintf("%s enabled=%v", feature, enabled)
+	})
+
+	events.entitlementCreate(core.Entitlement{ID: 1, Type: core.EntitlementTypePurchase, SkuID: 42})
+	fmt.Println(<-flips)
+	events.entitlementDelete(core.Entitlement{ID: 1, Type: core.EntitlementTypePurchase, SkuID: 42})
+	fmt.Println(<-flips)
+	// Output:
+	// soundtrack enabled=true
+	// soundtrack enabled=false
+}
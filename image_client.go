@@ -1,30 +1,141 @@
 package discord
 
 import (
+	"context"
+	"fmt"
+	"image"
 	"unsafe"
 
 	"github.com/andresperezl/discordgamesdk-go/core"
 )
 
+// ImageClient provides Go-like interfaces for image management
 type ImageClient struct {
 	manager *core.ImageManager
+	core    *core.Core
 }
 
+// NewImageClient creates an image client. Prefer Client.Image.
 func NewImageClient(core *core.Core) *ImageClient {
-	return &ImageClient{manager: core.GetImageManager()}
+	return &ImageClient{manager: core.GetImageManager(), core: core}
 }
 
 // Fetch fetches an image asynchronously (callback usage is up to the user)
+//
+// Deprecated: use FetchWithContext or FetchImage, which deliver the result to Go.
 func (c *ImageClient) Fetch(handle core.ImageHandle, refresh bool, callbackData, callback unsafe.Pointer) {
+	if c.manager == nil {
+		return
+	}
 	c.manager.Fetch(handle, refresh, callbackData, callback)
 }
 
-// GetDimensions retrieves the dimensions of an image
-func (c *ImageClient) GetDimensions(handle core.ImageHandle) (core.ImageDimensions, core.Result) {
-	return c.manager.GetDimensions(handle)
+// FetchWithContext fetches an image so its dimensions and data can be read,
+// respecting context cancellation and timeout. Set refresh to bypass Discord's image cache.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	handle, err := client.Image().FetchWithContext(ctx, core.ImageHandle{Type: core.ImageTypeUser, ID: userID, Size: 64}, false)
+//	if err != nil {
+//	    log.Fatalf("failed to fetch image: %v", err)
+//	}
+//	dims, err := client.Image().GetDimensions(handle)
+//
+// Returns the fetched handle or error if the context is cancelled, deadline exceeded, or the fetch fails.
+func (c *ImageClient) FetchWithContext(ctx context.Context, handle core.ImageHandle, refresh bool) (core.ImageHandle, error) {
+	if c.manager == nil {
+		return handle, fmt.Errorf("image manager not available")
+	}
+	handleChan := make(chan core.ImageHandle, 1)
+	errChan := make(chan error, 1)
+
+	c.manager.FetchAsync(handle, refresh, func(result core.Result, fetched core.ImageHandle) {
+		if result != core.ResultOk {
+			errChan <- fmt.Errorf("failed to fetch image: %v", result)
+			return
+		}
+		handleChan <- fetched
+	})
+
+	select {
+	case fetched := <-handleChan:
+		return fetched, nil
+	case err := <-errChan:
+		return handle, err
+	case <-ctx.Done():
+		return handle, ctx.Err()
+	}
+}
+
+// GetDimensions retrieves the dimensions of a fetched image
+func (c *ImageClient) GetDimensions(handle core.ImageHandle) (core.ImageDimensions, error) {
+	if c.manager == nil {
+		return core.ImageDimensions{}, fmt.Errorf("image manager not available")
+	}
+	dims, result := c.manager.GetDimensions(handle)
+	if result != core.ResultOk {
+		return core.ImageDimensions{}, fmt.Errorf("failed to get image dimensions: %v", result)
+	}
+	return dims, nil
+}
+
+// GetData copies the RGBA data of a fetched image into data, which must hold
+// width*height*4 bytes
+func (c *ImageClient) GetData(handle core.ImageHandle, data []byte) error {
+	if c.manager == nil {
+		return fmt.Errorf("image manager not available")
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("image data buffer is empty")
+	}
+	if result := c.manager.GetData(handle, data); result != core.ResultOk {
+		return fmt.Errorf("failed to get image data: %v", result)
+	}
+	return nil
 }
 
-// GetData retrieves the raw image data
-func (c *ImageClient) GetData(handle core.ImageHandle, data []byte) core.Result {
-	return c.manager.GetData(handle, data)
+// FetchImage fetches an image and decodes it, respecting context cancellation and timeout.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	avatar, err := client.Image().FetchImage(ctx, core.ImageHandle{Type: core.ImageTypeUser, ID: userID, Size: 128})
+//	if err != nil {
+//	    log.Fatalf("failed to fetch avatar: %v", err)
+//	}
+//	fmt.Printf("Avatar is %dx%d\n", avatar.Bounds().Dx(), avatar.Bounds().Dy())
+//
+// Returns the image or error if the context is cancelled, deadline exceeded, or the fetch fails.
+func (c *ImageClient) FetchImage(ctx context.Context, handle core.ImageHandle) (*image.RGBA, error) {
+	return c.fetchImage(ctx, handle, false)
+}
+
+// fetchImage fetches an image, asking Discord to bypass its cache when refresh is set
+func (c *ImageClient) fetchImage(ctx context.Context, handle core.ImageHandle, refresh bool) (*image.RGBA, error) {
+	fetched, err := c.FetchWithContext(ctx, handle, refresh)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetImage(fetched)
+}
+
+// GetImage decodes an image that has already been fetched.
+// Discord delivers images as 8-bit RGBA, which maps directly onto image.RGBA.
+func (c *ImageClient) GetImage(handle core.ImageHandle) (*image.RGBA, error) {
+	dims, err := c.GetDimensions(handle)
+	if err != nil {
+		return nil, err
+	}
+	if dims.Width == 0 || dims.Height == 0 {
+		return nil, fmt.Errorf("image has no pixels: %dx%d", dims.Width, dims.Height)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, int(dims.Width), int(dims.Height)))
+	if err := c.GetData(handle, img.Pix); err != nil {
+		return nil, err
+	}
+	return img, nil
 }
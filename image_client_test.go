@@ -0,0 +1,36 @@
+package discord
+
+import (
+	"context"
+	"image/png"
+	"log"
+	"os"
+	"time"
+
+	"github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleImageClient_FetchImage demonstrates how to fetch a user's avatar and save it as a PNG.
+// This example is for documentation only and requires a real, initialized ImageClient.
+func ExampleImageClient_FetchImage() {
+	var imageClient *ImageClient // Assume this is properly initialized
+	var userID int64             // Assume this is a real user ID
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	avatar, err := imageClient.FetchImage(ctx, core.ImageHandle{Type: core.ImageTypeUser, ID: userID, Size: 128})
+	if err != nil {
+		log.Fatalf("failed to fetch avatar: %v", err)
+	}
+
+	f, err := os.Create("avatar.png")
+	if err != nil {
+		log.Fatalf("failed to create file: %v", err)
+	}
+	defer f.Close()
+	if err := png.Encode(f, avatar); err != nil {
+		log.Fatalf("failed to encode avatar: %v", err)
+	}
+	// No Output: (documentation only)
+}
@@ -0,0 +1,58 @@
+// Package sdkfake provides in-memory implementations of SDK managers, so tests can
+// exercise the real C call path without the Discord client.
+package sdkfake
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../../lib
+#include <stdlib.h>
+#include <string.h>
+#include "discord_game_sdk.h"
+
+// fake_lobby_manager extends IDiscordLobbyManager with one metadata entry that is
+// returned for any lobby and member
+struct fake_lobby_manager {
+    struct IDiscordLobbyManager manager;
+    DiscordMetadataKey key;
+    DiscordMetadataValue value;
+};
+
+static enum EDiscordResult fake_lookup(struct IDiscordLobbyManager* manager, const char* key, DiscordMetadataValue* value) {
+    struct fake_lobby_manager* fake = (struct fake_lobby_manager*)manager;
+    if (strncmp(fake->key, key, sizeof(fake->key)) != 0) {
+        return DiscordResult_NotFound;
+    }
+    memcpy(*value, fake->value, sizeof(fake->value));
+    return DiscordResult_Ok;
+}
+
+static enum EDiscordResult DISCORD_API fake_get_lobby_metadata_value(struct IDiscordLobbyManager* manager, DiscordLobbyId lobby_id, DiscordMetadataKey key, DiscordMetadataValue* value) {
+    return fake_lookup(manager, key, value);
+}
+
+static enum EDiscordResult DISCORD_API fake_get_member_metadata_value(struct IDiscordLobbyManager* manager, DiscordLobbyId lobby_id, DiscordUserId user_id, DiscordMetadataKey key, DiscordMetadataValue* value) {
+    return fake_lookup(manager, key, value);
+}
+
+static struct IDiscordLobbyManager* fake_lobby_manager_new(const char* key, const char* value) {
+    struct fake_lobby_manager* fake = calloc(1, sizeof(struct fake_lobby_manager));
+    fake->manager.get_lobby_metadata_value = fake_get_lobby_metadata_value;
+    fake->manager.get_member_metadata_value = fake_get_member_metadata_value;
+    strncpy(fake->key, key, sizeof(fake->key) - 1);
+    strncpy(fake->value, value, sizeof(fake->value) - 1);
+    return &fake->manager;
+}
+*/
+import "C"
+import "unsafe"
+
+// LobbyManager returns an IDiscordLobbyManager whose lobby and member metadata hold the
+// single entry key=value for every lobby and member. Other keys report DiscordResult_NotFound.
+// The returned free function releases the manager.
+func LobbyManager(key, value string) (manager unsafe.Pointer, free func()) {
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+	cValue := C.CString(value)
+	defer C.free(unsafe.Pointer(cValue))
+	m := C.fake_lobby_manager_new(cKey, cValue)
+	return unsafe.Pointer(m), func() { C.free(unsafe.Pointer(m)) }
+}
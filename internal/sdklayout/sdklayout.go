@@ -0,0 +1,100 @@
+// Package sdklayout reports the C layout of the SDK structs that core mirrors with
+// Go structs, so tests can verify the mirrors against the C compiler.
+package sdklayout
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../../lib
+#include "discord_game_sdk.h"
+*/
+import "C"
+import "unsafe"
+
+// Struct describes the size and field offsets of a C struct
+type Struct struct {
+	Size    uintptr
+	Offsets map[string]uintptr
+}
+
+// Layouts returns the layouts of the SDK structs that core mirrors with Go structs,
+// keyed by C struct name
+func Layouts() map[string]Struct {
+	var user C.struct_DiscordUser
+	var activity C.struct_DiscordActivity
+	var presence C.struct_DiscordPresence
+	var rel C.struct_DiscordRelationship
+	var lobby C.struct_DiscordLobby
+	var inputMode C.struct_DiscordInputMode
+	var achievement C.struct_DiscordUserAchievement
+	return map[string]Struct{
+		"DiscordUser": {
+			Size: unsafe.Sizeof(user),
+			Offsets: map[string]uintptr{
+				"id":            unsafe.Offsetof(user.id),
+				"username":      unsafe.Offsetof(user.username),
+				"discriminator": unsafe.Offsetof(user.discriminator),
+				"avatar":        unsafe.Offsetof(user.avatar),
+				"bot":           unsafe.Offsetof(user.bot),
+			},
+		},
+		"DiscordActivity": {
+			Size: unsafe.Sizeof(activity),
+			Offsets: map[string]uintptr{
+				"type":                unsafe.Offsetof(activity._type),
+				"application_id":      unsafe.Offsetof(activity.application_id),
+				"name":                unsafe.Offsetof(activity.name),
+				"state":               unsafe.Offsetof(activity.state),
+				"details":             unsafe.Offsetof(activity.details),
+				"timestamps":          unsafe.Offsetof(activity.timestamps),
+				"assets":              unsafe.Offsetof(activity.assets),
+				"party":               unsafe.Offsetof(activity.party),
+				"party.size":          unsafe.Offsetof(activity.party.size),
+				"party.privacy":       unsafe.Offsetof(activity.party.privacy),
+				"secrets":             unsafe.Offsetof(activity.secrets),
+				"instance":            unsafe.Offsetof(activity.instance),
+				"supported_platforms": unsafe.Offsetof(activity.supported_platforms),
+			},
+		},
+		"DiscordPresence": {
+			Size: unsafe.Sizeof(presence),
+			Offsets: map[string]uintptr{
+				"status":   unsafe.Offsetof(presence.status),
+				"activity": unsafe.Offsetof(presence.activity),
+			},
+		},
+		"DiscordRelationship": {
+			Size: unsafe.Sizeof(rel),
+			Offsets: map[string]uintptr{
+				"type":     unsafe.Offsetof(rel._type),
+				"user":     unsafe.Offsetof(rel.user),
+				"presence": unsafe.Offsetof(rel.presence),
+			},
+		},
+		"DiscordLobby": {
+			Size: unsafe.Sizeof(lobby),
+			Offsets: map[string]uintptr{
+				"id":       unsafe.Offsetof(lobby.id),
+				"type":     unsafe.Offsetof(lobby._type),
+				"owner_id": unsafe.Offsetof(lobby.owner_id),
+				"secret":   unsafe.Offsetof(lobby.secret),
+				"capacity": unsafe.Offsetof(lobby.capacity),
+				"locked":   unsafe.Offsetof(lobby.locked),
+			},
+		},
+		"DiscordInputMode": {
+			Size: unsafe.Sizeof(inputMode),
+			Offsets: map[string]uintptr{
+				"type":     unsafe.Offsetof(inputMode._type),
+				"shortcut": unsafe.Offsetof(inputMode.shortcut),
+			},
+		},
+		"DiscordUserAchievement": {
+			Size: unsafe.Sizeof(achievement),
+			Offsets: map[string]uintptr{
+				"user_id":          unsafe.Offsetof(achievement.user_id),
+				"achievement_id":   unsafe.Offsetof(achievement.achievement_id),
+				"percent_complete": unsafe.Offsetof(achievement.percent_complete),
+				"unlocked_at":      unsafe.Offsetof(achievement.unlocked_at),
+			},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// JoinSuggestion is a friend currently in a party of this game that can be joined
+type JoinSuggestion struct {
+	Friend   core.User
+	Activity core.Activity
+}
+
+// OpenSlots returns the number of players that can still join the party
+func (s JoinSuggestion) OpenSlots() int32 {
+	return s.Activity.Party.Size.MaxSize - s.Activity.Party.Size.CurrentSize
+}
+
+// JoinSecret returns the activity secret used to join the party
+func (s JoinSuggestion) JoinSecret() string {
+	return s.Activity.Secrets.Join
+}
+
+// JoinSuggestions lists friends currently in a joinable party of this game: their
+// activity belongs to this application, the party is not full and it has a join secret.
+// Suggestions are ordered by fewest open slots first, then by username.
+//
+// Example usage:
+//
+//	suggestions, err := client.Relationship().JoinSuggestions(ctx)
+//	if err != nil {
+//	    log.Fatalf("failed to list join suggestions: %v", err)
+//	}
+//	for _, s := range suggestions {
+//	    fmt.Printf("%s (%d open slots)\n", s.Friend.Username, s.OpenSlots())
+//	}
+//
+// Returns the suggestions or error if the context is cancelled, deadline exceeded, or the application ID is unknown.
+func (c *RelationshipClient) JoinSuggestions(ctx context.Context) ([]JoinSuggestion, error) {
+	if c.applicationID == 0 {
+		return nil, fmt.Errorf("application ID not available")
+	}
+	rels, err := c.Friends(ctx, func(rel *core.Relationship) bool {
+		return joinable(&rel.Presence.Activity, c.applicationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return joinSuggestions(rels), nil
+}
+
+// Join connects to the lobby of a suggested party.
+//
+// Returns the joined lobby or error if the context is cancelled, deadline exceeded, or the connection fails.
+func (c *LobbyClient) Join(ctx context.Context, suggestion JoinSuggestion) (*core.Lobby, error) {
+	if suggestion.JoinSecret() == "" {
+		return nil, fmt.Errorf("user %d has no join secret", suggestion.Friend.ID)
+	}
+	return c.ConnectLobbyWithActivitySecretWithContext(ctx, suggestion.JoinSecret())
+}
+
+// JoinFriend joins the party a friend is currently in, after checking that it is a
+// joinable party of this game.
+//
+// Example usage:
+//
+//	lobby, err := client.JoinFriend(ctx, friendID)
+//	if err != nil {
+//	    log.Printf("failed to join friend: %v", err)
+//	    return
+//	}
+//	fmt.Printf("Joined lobby %d\n", lobby.ID)
+//
+// Returns the joined lobby or error if the context is cancelled, deadline exceeded,
+// the friend is not in a joinable party, or the connection fails.
+func (c *Client) JoinFriend(ctx context.Context, userID int64) (*core.Lobby, error) {
+	rel, err := c.Relationship().Get(userID)
+	if err != nil {
+		return nil, err
+	}
+	if rel.Type != core.RelationshipTypeFriend || !joinable(&rel.Presence.Activity, c.clientID) {
+		return nil, fmt.Errorf("user %d is not in a joinable party", userID)
+	}
+	return c.Lobby().Join(ctx, JoinSuggestion{Friend: rel.User, Activity: rel.Presence.Activity})
+}
+
+// joinable reports whether activity is a party of applicationID with room and a join secret
+func joinable(activity *core.Activity, applicationID int64) bool {
+	size := activity.Party.Size
+	return applicationID != 0 &&
+		activity.ApplicationID == applicationID &&
+		activity.Secrets.Join != "" &&
+		size.MaxSize > 0 && size.CurrentSize < size.MaxSize
+}
+
+func joinSuggestions(rels []core.Relationship) []JoinSuggestion {
+	suggestions := make([]JoinSuggestion, 0, len(rels))
+	for _, rel := range rels {
+		suggestions = append(suggestions, JoinSuggestion{Friend: rel.User, Activity: rel.Presence.Activity})
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if a, b := suggestions[i].OpenSlots(), suggestions[j].OpenSlots(); a != b {
+			return a < b
+		}
+		return suggestions[i].Friend.Username < suggestions[j].Friend.Username
+	})
+	return suggestions
+}
@@ -0,0 +1,39 @@
+package discord
+
+import (
+	"fmt"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleJoinSuggestion shows which friends' parties are suggested for joining.
+func ExampleJoinSuggestion() {
+	const gameID = 1234
+	party := func(name string, appID int64, current, max int32, secret string) core.Relationship {
+		rel := core.Relationship{Type: core.RelationshipTypeFriend, User: core.User{Username: name}}
+		rel.Presence.Activity.ApplicationID = appID
+		rel.Presence.Activity.Party.Size = core.PartySize{CurrentSize: current, MaxSize: max}
+		rel.Presence.Activity.Secrets.Join = secret
+		return rel
+	}
+	friends := []core.Relationship{
+		party("alice", gameID, 1, 4, "join-a"),
+		party("bob", gameID, 4, 4, "join-b"), // full
+		party("carol", gameID, 2, 4, ""),     // no join secret
+		party("dave", 5678, 1, 4, "join-d"),  // another game
+		party("erin", gameID, 3, 4, "join-e"),
+	}
+
+	var rels []core.Relationship
+	for _, rel := range friends {
+		if joinable(&rel.Presence.Activity, gameID) {
+			rels = append(rels, rel)
+		}
+	}
+	for _, s := range joinSuggestions(rels) {
+		fmt.Println(s.Friend.Username, s.OpenSlots(), s.JoinSecret())
+	}
+	// Output:
+	// erin 1 join-e
+	// alice 3 join-a
+}
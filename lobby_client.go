@@ -2,12 +2,16 @@ package discord
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"unsafe"
 
 	core "github.com/andresperezl/discordgamesdk-go/core"
 )
 
+// ErrMetadataNotFound is returned, wrapped, when a lobby or member has no metadata for the requested key
+var ErrMetadataNotFound = errors.New("metadata not found")
+
 // LobbyClient provides Go-like interfaces for lobby management
 type LobbyClient struct {
 	manager *core.LobbyManager
@@ -18,10 +22,52 @@ func NewLobbyClient(core *core.Core) *LobbyClient {
 	return &LobbyClient{manager: core.GetLobbyManager(), core: core}
 }
 
+// ConnectLobbyWithActivitySecret connects to a lobby using an activity secret (callback usage is up to the user)
+//
+// Deprecated: use ConnectLobbyWithActivitySecretWithContext, which delivers the joined lobby to Go.
 func (c *LobbyClient) ConnectLobbyWithActivitySecret(activitySecret string, callbackData, callback unsafe.Pointer) {
 	c.manager.ConnectLobbyWithActivitySecret(activitySecret, callbackData, callback)
 }
 
+// ConnectLobbyWithActivitySecretWithContext connects to a lobby using an activity secret,
+// such as the join secret of a friend's activity, respecting context cancellation and timeout.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+//	defer cancel()
+//	lobby, err := client.Lobby().ConnectLobbyWithActivitySecretWithContext(ctx, joinSecret)
+//	if err != nil {
+//	    log.Fatalf("failed to join lobby: %v", err)
+//	}
+//	fmt.Printf("Joined lobby %d\n", lobby.ID)
+//
+// Returns the joined lobby or error if the context is cancelled, deadline exceeded, or the connection fails.
+func (c *LobbyClient) ConnectLobbyWithActivitySecretWithContext(ctx context.Context, activitySecret string) (*core.Lobby, error) {
+	if c.manager == nil {
+		return nil, fmt.Errorf("lobby manager not available")
+	}
+	lobbyChan := make(chan *core.Lobby, 1)
+	errChan := make(chan error, 1)
+
+	c.manager.ConnectLobbyWithActivitySecretAsync(activitySecret, func(result core.Result, lobby *core.Lobby) {
+		if result != core.ResultOk {
+			errChan <- fmt.Errorf("failed to connect to lobby: %v", result)
+			return
+		}
+		lobbyChan <- lobby
+	})
+
+	select {
+	case lobby := <-lobbyChan:
+		return lobby, nil
+	case err := <-errChan:
+		return nil, err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 func (c *LobbyClient) GetMemberUpdateTransaction(lobbyID, userID int64) unsafe.Pointer {
 	return c.manager.GetMemberUpdateTransaction(lobbyID, userID)
 }
@@ -215,6 +261,9 @@ func (c *LobbyClient) GetLobbyMemberMetadataValue(lobbyID int64, userID int64, k
 	}
 
 	value, res := c.manager.GetMemberMetadataValue(lobbyID, userID, key)
+	if core.Result(res) == core.ResultNotFound {
+		return "", fmt.Errorf("lobby member metadata %q: %w", key, ErrMetadataNotFound)
+	}
 	if res != 0 {
 		return "", fmt.Errorf("failed to get lobby member metadata value: %v", res)
 	}
@@ -463,6 +512,9 @@ type NetworkMessageEvent struct {
 }
 
 // LobbyEventsChannel returns channels for key lobby events (member join/leave/update, lobby update/delete, message, speaking, network message).
+// Events are raised on the SDK callback loop, so an event is dropped when its channel's buffer is full;
+// streams that are not needed can be left unread. Each call adds its own subscription without
+// affecting other subscribers to the lobby events.
 //
 // Example usage:
 //
@@ -520,31 +572,55 @@ func (c *LobbyClient) LobbyEventsChannel() *LobbyEventChannels {
 	if c.core != nil {
 		events := &core.LobbyEvents{
 			OnMemberConnect: func(lobbyID, userID int64) {
-				memberJoin <- userID
+				select {
+				case memberJoin <- userID:
+				default:
+				}
 			},
 			OnMemberDisconnect: func(lobbyID, userID int64) {
-				memberLeave <- userID
+				select {
+				case memberLeave <- userID:
+				default:
+				}
 			},
 			OnLobbyMessage: func(lobbyID, userID int64, data []byte) {
-				lobbyMessage <- LobbyMessageEvent{LobbyID: lobbyID, UserID: userID, Data: data}
+				select {
+				case lobbyMessage <- LobbyMessageEvent{LobbyID: lobbyID, UserID: userID, Data: data}:
+				default:
+				}
 			},
 			OnLobbyUpdate: func(lobbyID int64) {
-				lobbyUpdate <- lobbyID
+				select {
+				case lobbyUpdate <- lobbyID:
+				default:
+				}
 			},
 			OnLobbyDelete: func(lobbyID int64, reason uint32) {
-				lobbyDelete <- LobbyDeleteEvent{LobbyID: lobbyID, Reason: reason}
+				select {
+				case lobbyDelete <- LobbyDeleteEvent{LobbyID: lobbyID, Reason: reason}:
+				default:
+				}
 			},
 			OnMemberUpdate: func(lobbyID, userID int64) {
-				memberUpdate <- MemberUpdateEvent{LobbyID: lobbyID, UserID: userID}
+				select {
+				case memberUpdate <- MemberUpdateEvent{LobbyID: lobbyID, UserID: userID}:
+				default:
+				}
 			},
 			OnSpeaking: func(lobbyID, userID int64, speakingVal bool) {
-				speaking <- SpeakingEvent{LobbyID: lobbyID, UserID: userID, Speaking: speakingVal}
+				select {
+				case speaking <- SpeakingEvent{LobbyID: lobbyID, UserID: userID, Speaking: speakingVal}:
+				default:
+				}
 			},
 			OnNetworkMessage: func(lobbyID, userID int64, channelID uint8, data []byte) {
-				networkMessage <- NetworkMessageEvent{LobbyID: lobbyID, UserID: userID, ChannelID: channelID, Data: data}
+				select {
+				case networkMessage <- NetworkMessageEvent{LobbyID: lobbyID, UserID: userID, ChannelID: channelID, Data: data}:
+				default:
+				}
 			},
 		}
-		c.core.SetLobbyEvents(events)
+		c.core.AddLobbyEvents(events)
 	}
 
 	return &LobbyEventChannels{
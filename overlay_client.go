@@ -11,6 +11,7 @@ import (
 type OverlayClient struct {
 	manager *core.OverlayManager
 	core    *core.Core
+	state   *overlayTracker
 }
 
 // IsEnabled checks if the overlay is enabled
@@ -37,10 +38,12 @@ func (oc *OverlayClient) SetLocked(locked bool) <-chan error {
 		close(errChan)
 		return errChan
 	}
+	t := oc.tracker()
 	oc.manager.SetLocked(locked, func(result core.Result) {
 		if result != core.ResultOk {
 			errChan <- fmt.Errorf("failed to set locked: %v", result)
 		} else {
+			t.setLocked(locked)
 			errChan <- nil
 		}
 		close(errChan)
@@ -154,10 +157,12 @@ func (oc *OverlayClient) SetLockedWithContext(ctx context.Context, locked bool)
 		return fmt.Errorf("overlay manager not available")
 	}
 	errChan := make(chan error, 1)
+	t := oc.tracker()
 	oc.manager.SetLocked(locked, func(result core.Result) {
 		if result != core.ResultOk {
 			errChan <- fmt.Errorf("failed to set locked: %v", result)
 		} else {
+			t.setLocked(locked)
 			errChan <- nil
 		}
 	})
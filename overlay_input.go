@@ -0,0 +1,152 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// overlayKeys maps engine key names to the key codes the overlay expects, which
+// follow the DOM KeyboardEvent key values ("a", "Enter", "ArrowUp", "Shift", ...)
+var overlayKeys = func() map[string]string {
+	keys := map[string]string{
+		"backspace": "Backspace", "tab": "Tab", "enter": "Enter", "return": "Enter",
+		"escape": "Escape", "esc": "Escape", "space": " ", "spacebar": " ",
+		"insert": "Insert", "delete": "Delete", "del": "Delete", "home": "Home", "end": "End",
+		"page up": "PageUp", "pageup": "PageUp", "pgup": "PageUp",
+		"page down": "PageDown", "pagedown": "PageDown", "pgdn": "PageDown",
+		"left": "ArrowLeft", "right": "ArrowRight", "up": "ArrowUp", "down": "ArrowDown",
+		"arrowleft": "ArrowLeft", "arrowright": "ArrowRight", "arrowup": "ArrowUp", "arrowdown": "ArrowDown",
+		"caps lock": "CapsLock", "capslock": "CapsLock", "num lock": "NumLock", "numlock": "NumLock",
+		"scroll lock": "ScrollLock", "scrolllock": "ScrollLock",
+		"shift": "Shift", "ctrl": "Control", "control": "Control", "alt": "Alt", "option": "Alt",
+		"meta": "Meta", "cmd": "Meta", "command": "Meta", "super": "Meta", "win": "Meta",
+	}
+	for c := 'a'; c <= 'z'; c++ {
+		keys[string(c)] = string(c)
+	}
+	for c := '0'; c <= '9'; c++ {
+		keys[string(c)] = string(c)
+	}
+	for i := 1; i <= 24; i++ {
+		keys[fmt.Sprintf("f%d", i)] = fmt.Sprintf("F%d", i)
+	}
+	for _, c := range "`-=[]\\;',./" {
+		keys[string(c)] = string(c)
+	}
+	return keys
+}()
+
+// TranslateOverlayKey converts an engine key name such as "Esc", "Page Up" or "Left Shift"
+// into the key code the overlay expects. A "left" or "right" prefix on a modifier
+// selects the key variant; otherwise variant is returned unchanged.
+func TranslateOverlayKey(name string, variant core.KeyVariant) (string, core.KeyVariant, error) {
+	key := strings.Join(strings.Fields(strings.ToLower(name)), " ")
+	for prefix, v := range map[string]core.KeyVariant{"left ": core.KeyVariantLeft, "right ": core.KeyVariantRight} {
+		if rest, ok := strings.CutPrefix(key, prefix); ok {
+			if _, modifier := modifierAliases[rest]; modifier {
+				key, variant = rest, v
+			}
+		}
+	}
+	code, ok := overlayKeys[key]
+	if !ok {
+		return "", variant, fmt.Errorf("unknown overlay key %q", name)
+	}
+	return code, variant, nil
+}
+
+// OverlayInput forwards the game's keyboard and mouse input to the Discord overlay
+// and reports whether the overlay consumed it, so the game can skip its own handling.
+// Input is consumed while State reports the overlay open; mouse input is also
+// consumed inside the overlay's click zones, such as notifications.
+type OverlayInput struct {
+	overlay *OverlayClient
+}
+
+// Input returns an input bridge for the overlay. It is only needed when the game
+// renders the overlay itself; see InitDrawingDXGI.
+//
+// Example usage:
+//
+//	input := client.Overlay().Input()
+//	// In the engine's key handler:
+//	consumed, err := input.Key(ev.Down, ev.KeyName, core.KeyVariantNormal)
+//	if err == nil && consumed {
+//	    return
+//	}
+func (oc *OverlayClient) Input() *OverlayInput {
+	return &OverlayInput{overlay: oc}
+}
+
+// Key forwards a key press or release. name is translated with TranslateOverlayKey.
+//
+// Returns whether the overlay consumed the key, or an error if the key name or variant is unknown.
+func (in *OverlayInput) Key(down bool, name string, variant core.KeyVariant) (bool, error) {
+	if in.overlay.manager == nil {
+		return false, fmt.Errorf("overlay manager not available")
+	}
+	if variant < core.KeyVariantNormal || variant > core.KeyVariantLeft {
+		return false, fmt.Errorf("unknown key variant %d", variant)
+	}
+	code, variant, err := TranslateOverlayKey(name, variant)
+	if err != nil {
+		return false, err
+	}
+	open := in.open()
+	in.overlay.manager.KeyEvent(down, code, variant)
+	return open, nil
+}
+
+// Char forwards typed text
+//
+// Returns whether the overlay consumed the text.
+func (in *OverlayInput) Char(text string) (bool, error) {
+	if in.overlay.manager == nil {
+		return false, fmt.Errorf("overlay manager not available")
+	}
+	if text == "" {
+		return false, nil
+	}
+	open := in.open()
+	in.overlay.manager.CharEvent(text)
+	return open, nil
+}
+
+// MouseButton forwards a mouse button press or release at x, y
+//
+// Returns whether the overlay consumed the click, or an error if the button is unknown.
+func (in *OverlayInput) MouseButton(down bool, clickCount int32, button core.MouseButton, x, y int32) (bool, error) {
+	if in.overlay.manager == nil {
+		return false, fmt.Errorf("overlay manager not available")
+	}
+	if button < core.MouseButtonLeft || button > core.MouseButtonRight {
+		return false, fmt.Errorf("unknown mouse button %d", button)
+	}
+	var d uint8
+	if down {
+		d = 1
+	}
+	open := in.open()
+	in.overlay.manager.MouseButtonEvent(d, clickCount, button, x, y)
+	return open || in.overlay.manager.IsPointInsideClickZone(x, y), nil
+}
+
+// MouseMotion forwards the mouse position
+//
+// Returns whether the overlay consumed the motion.
+func (in *OverlayInput) MouseMotion(x, y int32) (bool, error) {
+	if in.overlay.manager == nil {
+		return false, fmt.Errorf("overlay manager not available")
+	}
+	open := in.open()
+	in.overlay.manager.MouseMotionEvent(x, y)
+	return open || in.overlay.manager.IsPointInsideClickZone(x, y), nil
+}
+
+// open reports whether the overlay is open from the shared tracker state, captured
+// before the event is forwarded so no SDK round-trip is added per event
+func (in *OverlayInput) open() bool {
+	return in.overlay.cachedState().Open()
+}
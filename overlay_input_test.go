@@ -0,0 +1,52 @@
+package discord
+
+import (
+	"fmt"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleTranslateOverlayKey demonstrates translating engine key names to overlay key codes.
+func ExampleTranslateOverlayKey() {
+	for _, name := range []string{"A", "Esc", "Page  Up", "Left Shift", "right ctrl", "F5", "Space", "Hyper"} {
+		code, variant, err := TranslateOverlayKey(name, core.KeyVariantNormal)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Printf("%q variant=%d\n", code, variant)
+	}
+	// Output:
+	// "a" variant=0
+	// "Escape" variant=0
+	// "PageUp" variant=0
+	// "Shift" variant=2
+	// "Control" variant=1
+	// "F5" variant=0
+	// " " variant=0
+	// unknown overlay key "Hyper"
+}
+
+// ExampleOverlayInput_Key demonstrates that consumption follows the overlay state kept
+// by the client's tracker, without asking Discord on every event.
+func ExampleOverlayInput_Key() {
+	events := &testEvents{}
+	t := &overlayTracker{state: OverlayState{Enabled: true, Locked: false}, known: true}
+	t.listen(events)
+	defer t.close()
+	// The manager is not connected to Discord; reading it would report the overlay as disabled
+	oc := &OverlayClient{manager: &core.OverlayManager{}, state: t}
+	input := oc.Input()
+
+	consumed, err := input.Key(true, "Esc", core.KeyVariantNormal)
+	fmt.Println(consumed, err)
+	events.overlayToggle(true) // overlay closed
+	consumed, err = input.Char("w")
+	fmt.Println(consumed, err)
+	_, err = input.Key(true, "Hyper", core.KeyVariantNormal)
+	fmt.Println(err)
+	// Output:
+	// true <nil>
+	// false <nil>
+	// unknown overlay key "Hyper"
+}
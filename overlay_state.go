@@ -0,0 +1,189 @@
+package discord
+
+import (
+	"fmt"
+	"sync"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// OverlayState is a snapshot of the Discord overlay
+type OverlayState struct {
+	Enabled bool
+	// Locked is true while the overlay is closed and input goes to the game
+	Locked bool
+}
+
+// Open reports whether the overlay is open and capturing input, so the game should pause input handling
+func (s OverlayState) Open() bool {
+	return s.Enabled && !s.Locked
+}
+
+// overlayTracker keeps the last known overlay state for a Client and fans out
+// OnToggle events. It is shared by every OverlayClient returned by Client.Overlay.
+type overlayTracker struct {
+	unsubscribe func()
+	changes     broadcaster[OverlayState]
+
+	seedOnce sync.Once
+
+	mu         sync.Mutex
+	state      OverlayState
+	known      bool
+	registered bool
+	closed     bool
+}
+
+// tracker returns the shared overlay tracker, or a private one for clients not created by Client.Overlay
+func (oc *OverlayClient) tracker() *overlayTracker {
+	if oc.state == nil {
+		oc.state = &overlayTracker{}
+	}
+	return oc.state
+}
+
+// State returns the last known overlay state. It is read from Discord once and then
+// kept current by OnToggle events and SetLocked, so it does not wait for the SDK thread.
+//
+// Example usage:
+//
+//	state, err := client.Overlay().State()
+//	if err != nil {
+//	    log.Fatalf("failed to get overlay state: %v", err)
+//	}
+//	if state.Open() {
+//	    pauseInput()
+//	}
+//
+// Returns an error if the overlay manager is not available.
+func (oc *OverlayClient) State() (OverlayState, error) {
+	if oc.manager == nil {
+		return OverlayState{}, fmt.Errorf("overlay manager not available")
+	}
+	return oc.cachedState(), nil
+}
+
+// ReadState reads the overlay state from Discord. Unlike State it waits for the SDK
+// thread, and it does not change the state reported by State or Toggles.
+//
+// Returns an error if the overlay manager is not available.
+func (oc *OverlayClient) ReadState() (OverlayState, error) {
+	if oc.manager == nil {
+		return OverlayState{}, fmt.Errorf("overlay manager not available")
+	}
+	return OverlayState{Enabled: oc.manager.IsEnabled(), Locked: oc.manager.IsLocked()}, nil
+}
+
+// cachedState returns the tracker's state, subscribing and seeding it on first use
+func (oc *OverlayClient) cachedState() OverlayState {
+	t := oc.tracker()
+	oc.watch(t)
+	t.seed(oc.manager)
+	return t.current()
+}
+
+// Toggles returns a channel that receives the overlay state every time the overlay
+// is locked or unlocked. Each call returns a new channel; all channels are closed
+// when the client is closed.
+//
+// Example usage:
+//
+//	go func() {
+//	    for state := range client.Overlay().Toggles() {
+//	        if state.Open() {
+//	            pauseInput()
+//	        } else {
+//	            resumeInput()
+//	        }
+//	    }
+//	}()
+func (oc *OverlayClient) Toggles() <-chan OverlayState {
+	t := oc.tracker()
+	if oc.manager == nil {
+		ch := make(chan OverlayState)
+		close(ch)
+		return ch
+	}
+	oc.watch(t)
+	return t.changes.subscribe(8)
+}
+
+// watch subscribes the tracker to the overlay events of the client's core once
+func (oc *OverlayClient) watch(t *overlayTracker) {
+	if oc.core != nil {
+		t.listen(oc.core)
+	}
+}
+
+// listen subscribes the tracker to the overlay events of source, unless it already is
+func (t *overlayTracker) listen(source eventSource) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.registered || t.closed {
+		return
+	}
+	t.registered = true
+	t.unsubscribe = source.AddOverlayEvents(&core.OverlayEvents{OnToggle: t.setLocked})
+}
+
+// setLocked records a lock state change, such as an OnToggle event
+func (t *overlayTracker) setLocked(locked bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	state := t.state
+	if !t.known {
+		// A toggle implies the overlay is enabled
+		state.Enabled = true
+	}
+	state.Locked = locked
+	t.record(state)
+}
+
+// seed reads the initial state from Discord once. An OnToggle event recorded
+// before the read completes is newer, so the read is then discarded.
+func (t *overlayTracker) seed(manager *core.OverlayManager) {
+	t.seedOnce.Do(func() {
+		if manager == nil {
+			return
+		}
+		// Read without holding t.mu: the reads wait for the SDK thread, which takes t.mu in OnToggle
+		state := OverlayState{Enabled: manager.IsEnabled(), Locked: manager.IsLocked()}
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if !t.known {
+			t.state, t.known = state, true
+		}
+	})
+}
+
+// current returns the last known state
+func (t *overlayTracker) current() OverlayState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.state
+}
+
+// record stores state and delivers it to every subscriber if it differs from the
+// last known state. Callers must hold t.mu.
+func (t *overlayTracker) record(state OverlayState) {
+	if t.closed || (t.known && t.state == state) {
+		return
+	}
+	t.state = state
+	t.known = true
+	t.changes.emit(state)
+}
+
+// close unsubscribes from the overlay events and closes all channels returned by Toggles
+func (t *overlayTracker) close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return
+	}
+	t.closed = true
+	if t.unsubscribe != nil {
+		t.unsubscribe()
+	}
+	t.changes.close()
+}
@@ -0,0 +1,84 @@
+package discord
+
+import (
+	"fmt"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleOverlayClient_Toggles demonstrates the overlay states delivered as OnToggle events
+// report the overlay opening and closing.
+func ExampleOverlayClient_Toggles() {
+	events := &testEvents{}
+	t := &overlayTracker{state: OverlayState{Enabled: true, Locked: true}, known: true}
+	t.listen(events)
+	oc := &OverlayClient{manager: &core.OverlayManager{}, state: t}
+	toggles := oc.Toggles()
+
+	events.overlayToggle(false) // overlay opened
+	events.overlayToggle(false) // duplicate, ignored
+	events.overlayToggle(true)  // overlay closed
+	t.close()
+	events.overlayToggle(false) // after close
+
+	for state := range toggles {
+		fmt.Println("open:", state.Open())
+	}
+	// Output:
+	// open: true
+	// open: false
+}
+
+// ExampleOverlayClient_State demonstrates that State is read from Discord once and then
+// follows OnToggle events, while ReadState reads Discord without changing it.
+func ExampleOverlayClient_State() {
+	events := &testEvents{}
+	t := &overlayTracker{}
+	t.listen(events)
+	defer t.close()
+	// The manager is not connected to Discord, so it reports the overlay as disabled and unlocked
+	oc := &OverlayClient{manager: &core.OverlayManager{}, state: t}
+	toggles := oc.Toggles()
+
+	state, _ := oc.State()
+	fmt.Printf("seeded: %+v\n", state)
+
+	events.overlayToggle(true)
+	fmt.Printf("toggle: %+v\n", <-toggles)
+	state, _ = oc.State()
+	fmt.Printf("state: %+v\n", state)
+
+	live, _ := oc.ReadState()
+	fmt.Printf("live: %+v\n", live)
+	state, _ = oc.State()
+	fmt.Printf("state: %+v\n", state)
+	select {
+	case s := <-toggles:
+		fmt.Printf("unexpected toggle: %+v\n", s)
+	default:
+		fmt.Println("no further toggles")
+	}
+	// Output:
+	// seeded: {Enabled:false Locked:false}
+	// toggle: {Enabled:false Locked:true}
+	// state: {Enabled:false Locked:true}
+	// live: {Enabled:false Locked:false}
+	// state: {Enabled:false Locked:true}
+	// no further toggles
+}
+
+// Example_overlayTrackerSeed demonstrates that a toggle recorded before the seed read
+// completes is kept, rather than overwritten by the older read.
+func Example_overlayTrackerSeed() {
+	events := &testEvents{}
+	t := &overlayTracker{}
+	t.listen(events)
+	defer t.close()
+	oc := &OverlayClient{manager: &core.OverlayManager{}, state: t}
+
+	events.overlayToggle(false) // overlay opened before the first State call
+	state, _ := oc.State()
+	fmt.Printf("state: %+v open: %v\n", state, state.Open())
+	// Output:
+	// state: {Enabled:true Locked:false} open: true
+}
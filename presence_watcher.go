@@ -0,0 +1,305 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// PresenceChangeType describes how a relationship's presence changed
+type PresenceChangeType int
+
+const (
+	// RelationshipAdded is emitted when a relationship appears
+	RelationshipAdded PresenceChangeType = iota
+	// RelationshipRemoved is emitted when a relationship disappears
+	RelationshipRemoved
+	// FriendCameOnline is emitted when a user goes from offline to any other status
+	FriendCameOnline
+	// FriendWentOffline is emitted when a user goes offline
+	FriendWentOffline
+	// FriendStatusChanged is emitted when a user switches between online, idle and do not disturb
+	FriendStatusChanged
+	// FriendStartedPlaying is emitted when a user starts an activity of this game
+	FriendStartedPlaying
+	// FriendStoppedPlaying is emitted when a user's activity stops belonging to this game
+	FriendStoppedPlaying
+	// FriendPartyChanged is emitted when a user's party ID or size changes
+	FriendPartyChanged
+)
+
+// String returns a string representation of the PresenceChangeType
+func (t PresenceChangeType) String() string {
+	switch t {
+	case RelationshipAdded:
+		return "RelationshipAdded"
+	case RelationshipRemoved:
+		return "RelationshipRemoved"
+	case FriendCameOnline:
+		return "FriendCameOnline"
+	case FriendWentOffline:
+		return "FriendWentOffline"
+	case FriendStatusChanged:
+		return "FriendStatusChanged"
+	case FriendStartedPlaying:
+		return "FriendStartedPlaying"
+	case FriendStoppedPlaying:
+		return "FriendStoppedPlaying"
+	case FriendPartyChanged:
+		return "FriendPartyChanged"
+	default:
+		return fmt.Sprintf("PresenceChangeType(%d)", int(t))
+	}
+}
+
+// PresenceChange represents a single change to a relationship.
+// Old is the zero value for RelationshipAdded and New is the zero value for RelationshipRemoved.
+type PresenceChange struct {
+	Type PresenceChangeType
+	Old  core.Relationship
+	New  core.Relationship
+}
+
+// PresenceWatcher keeps a snapshot of all relationships in sync with the
+// relationship manager's OnRefresh and OnRelationshipUpdate events and emits
+// typed diffs as friends come online, start playing or change parties.
+//
+// Relationships of type None or Blocked are not kept; a relationship that changes
+// to either is reported as RelationshipRemoved.
+type PresenceWatcher struct {
+	relationships *RelationshipClient
+	applicationID int64
+	read          func(apply func(rels []core.Relationship)) // see core.RelationshipManager.Snapshot
+	unsubscribe   func()
+
+	mu       sync.RWMutex
+	snapshot map[int64]core.Relationship // keyed by user ID
+	changes  broadcaster[PresenceChange]
+}
+
+// WatchPresence takes a snapshot of all relationships and returns a watcher that
+// keeps it up to date as relationship events arrive. The snapshot is read without
+// changing the relationship filter used by Count and GetAt.
+//
+// Example usage:
+//
+//	watcher, err := client.Relationship().WatchPresence(ctx)
+//	if err != nil {
+//	    log.Fatalf("failed to watch presence: %v", err)
+//	}
+//	defer watcher.Close()
+//	go func() {
+//	    for change := range watcher.Changes() {
+//	        if change.Type == discord.FriendStartedPlaying {
+//	            fmt.Printf("%s joined the game\n", change.New.User.Username)
+//	        }
+//	    }
+//	}()
+//
+// Returns an error if the context is cancelled, deadline exceeded, or the initial snapshot fails.
+func (c *RelationshipClient) WatchPresence(ctx context.Context) (*PresenceWatcher, error) {
+	if c.manager == nil {
+		return nil, fmt.Errorf("relationship manager not available")
+	}
+
+	w := newPresenceWatcher(c)
+	// Subscribe before the first snapshot so updates raised while it is read are not missed
+	if c.core != nil {
+		w.listen(c.core)
+	}
+	if err := w.Refresh(ctx); err != nil {
+		w.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func newPresenceWatcher(relationships *RelationshipClient) *PresenceWatcher {
+	w := &PresenceWatcher{
+		relationships: relationships,
+		applicationID: relationships.applicationID,
+		snapshot:      make(map[int64]core.Relationship),
+	}
+	if relationships.manager != nil {
+		w.read = relationships.manager.Snapshot
+	}
+	return w
+}
+
+// listen subscribes the watcher to the relationship events of source until Close
+func (w *PresenceWatcher) listen(source eventSource) {
+	w.unsubscribe = source.AddRelationshipEvents(&core.RelationshipEvents{
+		// Events are delivered on the SDK thread, so the snapshot is read and applied
+		// inline, before any later relationship update
+		OnRefresh: func() {
+			if w.read != nil {
+				w.read(w.apply)
+			}
+		},
+		OnRelationshipUpdate: w.handleUpdate,
+	})
+}
+
+// Refresh re-reads all relationships and emits changes for any differences with the snapshot.
+// Snapshots and relationship events are applied in the order the SDK produced them.
+//
+// Returns an error if the context is cancelled or deadline exceeded before the snapshot is read;
+// the snapshot is still applied once it completes.
+func (w *PresenceWatcher) Refresh(ctx context.Context) error {
+	if w.read == nil {
+		return fmt.Errorf("relationship manager not available")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	go func() {
+		w.read(w.apply)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// apply replaces the snapshot with rels and emits the differences
+func (w *PresenceWatcher) apply(rels []core.Relationship) {
+	fresh := make(map[int64]core.Relationship, len(rels))
+	for _, rel := range rels {
+		if presenceTracked(rel) {
+			fresh[rel.User.ID] = rel
+		}
+	}
+
+	w.mu.Lock()
+	var changes []PresenceChange
+	for id, old := range w.snapshot {
+		if _, ok := fresh[id]; !ok {
+			changes = append(changes, PresenceChange{Type: RelationshipRemoved, Old: old})
+		}
+	}
+	for id, rel := range fresh {
+		old, ok := w.snapshot[id]
+		if !ok {
+			changes = append(changes, PresenceChange{Type: RelationshipAdded, New: rel})
+			continue
+		}
+		changes = append(changes, diffPresence(old, rel, w.applicationID)...)
+	}
+	w.snapshot = fresh
+	w.mu.Unlock()
+
+	for _, change := range changes {
+		w.changes.emit(change)
+	}
+}
+
+// Get returns the latest known relationship with a user
+func (w *PresenceWatcher) Get(userID int64) (core.Relationship, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	rel, ok := w.snapshot[userID]
+	return rel, ok
+}
+
+// Snapshot returns a copy of all known relationships
+func (w *PresenceWatcher) Snapshot() []core.Relationship {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	rels := make([]core.Relationship, 0, len(w.snapshot))
+	for _, rel := range w.snapshot {
+		rels = append(rels, rel)
+	}
+	return rels
+}
+
+// Changes returns a channel that receives every presence change from now on.
+// Each call returns a new channel; all channels are closed by Close.
+func (w *PresenceWatcher) Changes() <-chan PresenceChange {
+	return w.changes.subscribe(8)
+}
+
+// Close stops delivering changes and closes all channels returned by Changes.
+// Other subscribers to the relationship events are not affected.
+func (w *PresenceWatcher) Close() {
+	if !w.changes.close() {
+		return
+	}
+	if w.unsubscribe != nil {
+		w.unsubscribe()
+	}
+}
+
+func (w *PresenceWatcher) handleUpdate(rel *core.Relationship) {
+	if rel == nil {
+		return
+	}
+	w.mu.Lock()
+	old, exists := w.snapshot[rel.User.ID]
+	if presenceTracked(*rel) {
+		w.snapshot[rel.User.ID] = *rel
+	} else {
+		delete(w.snapshot, rel.User.ID)
+	}
+	w.mu.Unlock()
+
+	switch {
+	case !presenceTracked(*rel):
+		if exists {
+			w.changes.emit(PresenceChange{Type: RelationshipRemoved, Old: old})
+		}
+	case !exists:
+		w.changes.emit(PresenceChange{Type: RelationshipAdded, New: *rel})
+	default:
+		for _, change := range diffPresence(old, *rel, w.applicationID) {
+			w.changes.emit(change)
+		}
+	}
+}
+
+// presenceTracked reports whether rel belongs in the snapshot
+func presenceTracked(rel core.Relationship) bool {
+	return rel.Type != core.RelationshipTypeNone && rel.Type != core.RelationshipTypeBlocked
+}
+
+// diffPresence lists the changes between two versions of the same relationship.
+// Playing is only tracked when applicationID is set.
+func diffPresence(old, new core.Relationship, applicationID int64) []PresenceChange {
+	var changes []PresenceChange
+	add := func(t PresenceChangeType) {
+		changes = append(changes, PresenceChange{Type: t, Old: old, New: new})
+	}
+
+	oldStatus, newStatus := old.Presence.Status, new.Presence.Status
+	switch {
+	case oldStatus == newStatus:
+	case oldStatus == core.StatusOffline:
+		add(FriendCameOnline)
+	case newStatus == core.StatusOffline:
+		add(FriendWentOffline)
+	default:
+		add(FriendStatusChanged)
+	}
+
+	if applicationID != 0 {
+		wasPlaying := old.Presence.Activity.ApplicationID == applicationID
+		isPlaying := new.Presence.Activity.ApplicationID == applicationID
+		if !wasPlaying && isPlaying {
+			add(FriendStartedPlaying)
+		} else if wasPlaying && !isPlaying {
+			add(FriendStoppedPlaying)
+		}
+	}
+
+	if old.Presence.Activity.Party.ID != new.Presence.Activity.Party.ID ||
+		old.Presence.Activity.Party.Size != new.Presence.Activity.Party.Size {
+		add(FriendPartyChanged)
+	}
+	return changes
+}
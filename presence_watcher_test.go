@@ -0,0 +1,67 @@
+package discord
+
+import (
+	"fmt"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExamplePresenceWatcher demonstrates the typed changes emitted as a friend's presence updates.
+func ExamplePresenceWatcher() {
+	const gameID = 1234
+	events := &testEvents{}
+	w := newPresenceWatcher(&RelationshipClient{applicationID: gameID})
+	w.listen(events)
+	changes := w.Changes()
+
+	friend := core.Relationship{Type: core.RelationshipTypeFriend, User: core.User{ID: 1, Username: "alice"}}
+	events.relationshipUpdate(friend)
+
+	friend.Presence.Status = core.StatusOnline
+	friend.Presence.Activity = core.Activity{
+		ApplicationID: gameID,
+		Party:         core.ActivityParty{ID: "lobby-1", Size: core.PartySize{CurrentSize: 1, MaxSize: 4}},
+	}
+	events.relationshipUpdate(friend)
+
+	friend.Type = core.RelationshipTypeBlocked
+	events.relationshipUpdate(friend)
+	w.Close()
+
+	for change := range changes {
+		fmt.Printf("%v old=%q new=%q\n", change.Type, change.Old.User.Username, change.New.User.Username)
+	}
+	_, ok := w.Get(1)
+	fmt.Println(ok)
+	// Output:
+	// RelationshipAdded old="" new="alice"
+	// FriendCameOnline old="alice" new="alice"
+	// FriendStartedPlaying old="alice" new="alice"
+	// FriendPartyChanged old="alice" new="alice"
+	// RelationshipRemoved old="alice" new=""
+	// false
+}
+
+// ExamplePresenceWatcher_Refresh demonstrates how OnRefresh events resynchronize the snapshot.
+func ExamplePresenceWatcher_Refresh() {
+	events := &testEvents{}
+	rels := []core.Relationship{
+		{Type: core.RelationshipTypeFriend, User: core.User{ID: 1, Username: "alice"}},
+		{Type: core.RelationshipTypeBlocked, User: core.User{ID: 2, Username: "mallory"}},
+	}
+	w := newPresenceWatcher(&RelationshipClient{})
+	w.read = func(apply func(rels []core.Relationship)) { apply(rels) }
+	w.listen(events)
+	defer w.Close()
+	changes := w.Changes()
+
+	events.relationshipRefresh()
+	fmt.Println((<-changes).Type, len(w.Snapshot()))
+
+	rels = rels[1:]
+	events.relationshipRefresh()
+	fmt.Println((<-changes).Type, len(w.Snapshot()))
+	// Output:
+	// RelationshipAdded 1
+	// RelationshipRemoved 0
+}
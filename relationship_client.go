@@ -1,35 +1,171 @@
 package discord
 
 import (
+	"context"
+	"fmt"
 	"unsafe"
 
 	"github.com/andresperezl/discordgamesdk-go/core"
 )
 
+// RelationshipClient provides Go-like interfaces for relationship management
 type RelationshipClient struct {
-	manager *core.RelationshipManager
+	manager       *core.RelationshipManager
+	core          *core.Core
+	applicationID int64 // this game's application ID, used by FriendsInGame
 }
 
+// NewRelationshipClient creates a relationship client. Prefer Client.Relationship.
 func NewRelationshipClient(core *core.Core) *RelationshipClient {
-	return &RelationshipClient{manager: core.GetRelationshipManager()}
+	return &RelationshipClient{manager: core.GetRelationshipManager(), core: core}
 }
 
 // Filter filters relationships using a callback function
+//
+// Deprecated: the filter callback is not wired to the SDK and has no effect. Use Relationships.
 func (c *RelationshipClient) Filter(filterData, filter unsafe.Pointer) {
+	if c.manager == nil {
+		return
+	}
 	c.manager.Filter(filterData, filter)
 }
 
-// Count returns the number of relationships
-func (c *RelationshipClient) Count() (int32, core.Result) {
-	return c.manager.Count()
+// Count returns the number of relationships matching the last filter
+func (c *RelationshipClient) Count() (int32, error) {
+	if c.manager == nil {
+		return 0, fmt.Errorf("relationship manager not available")
+	}
+	count, result := c.manager.Count()
+	if result != core.ResultOk {
+		return 0, fmt.Errorf("failed to count relationships: %v", result)
+	}
+	return count, nil
 }
 
-// Get retrieves a relationship by user ID
-func (c *RelationshipClient) Get(userID int64) (*core.Relationship, core.Result) {
-	return c.manager.Get(userID)
+// Get retrieves the relationship with a user
+func (c *RelationshipClient) Get(userID int64) (*core.Relationship, error) {
+	if c.manager == nil {
+		return nil, fmt.Errorf("relationship manager not available")
+	}
+	rel, result := c.manager.Get(userID)
+	if result != core.ResultOk {
+		return nil, fmt.Errorf("failed to get relationship: %v", result)
+	}
+	return rel, nil
 }
 
-// GetAt retrieves a relationship by index
-func (c *RelationshipClient) GetAt(index uint32) (*core.Relationship, core.Result) {
-	return c.manager.GetAt(index)
+// GetAt retrieves a relationship by index in the filtered list
+func (c *RelationshipClient) GetAt(index uint32) (*core.Relationship, error) {
+	if c.manager == nil {
+		return nil, fmt.Errorf("relationship manager not available")
+	}
+	rel, result := c.manager.GetAt(index)
+	if result != core.ResultOk {
+		return nil, fmt.Errorf("failed to get relationship at index: %v", result)
+	}
+	return rel, nil
+}
+
+// Relationships returns every relationship accepted by predicate, respecting context
+// cancellation and timeout. A nil predicate accepts all relationships.
+//
+// The predicate runs on the calling goroutine after the relationships are read. Reading
+// them does not change the filter seen by Count and GetAt: a filter set with FilterFunc
+// is re-applied afterwards, so its predicate runs again. If no filter was set, Count and
+// GetAt see every relationship afterwards.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+//	defer cancel()
+//	pending, err := client.Relationship().Relationships(ctx, func(rel *core.Relationship) bool {
+//	    return rel.Type == core.RelationshipTypePendingIncoming
+//	})
+//	if err != nil {
+//	    log.Fatalf("failed to list relationships: %v", err)
+//	}
+//
+// Returns the relationships or error if the context is cancelled or deadline exceeded.
+func (c *RelationshipClient) Relationships(ctx context.Context, predicate func(rel *core.Relationship) bool) ([]core.Relationship, error) {
+	if c.manager == nil {
+		return nil, fmt.Errorf("relationship manager not available")
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	resultChan := make(chan []core.Relationship, 1)
+
+	go c.manager.Snapshot(func(rels []core.Relationship) {
+		resultChan <- rels
+	})
+
+	select {
+	case rels := <-resultChan:
+		return filterRelationships(rels, predicate), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// filterRelationships returns the relationships accepted by predicate, or all of them if predicate is nil
+func filterRelationships(rels []core.Relationship, predicate func(rel *core.Relationship) bool) []core.Relationship {
+	if predicate == nil {
+		return rels
+	}
+	var matches []core.Relationship
+	for i := range rels {
+		if predicate(&rels[i]) {
+			matches = append(matches, rels[i])
+		}
+	}
+	return matches
+}
+
+// Friends returns the friends accepted by predicate. A nil predicate returns all friends.
+func (c *RelationshipClient) Friends(ctx context.Context, predicate func(rel *core.Relationship) bool) ([]core.Relationship, error) {
+	return c.Relationships(ctx, func(rel *core.Relationship) bool {
+		return rel.Type == core.RelationshipTypeFriend && (predicate == nil || predicate(rel))
+	})
+}
+
+// OnlineFriends returns the friends whose status is not offline
+func (c *RelationshipClient) OnlineFriends(ctx context.Context) ([]core.Relationship, error) {
+	return c.Friends(ctx, func(rel *core.Relationship) bool {
+		return rel.Presence.Status != core.StatusOffline
+	})
+}
+
+// FriendsPlaying returns the friends whose current activity belongs to applicationID
+func (c *RelationshipClient) FriendsPlaying(ctx context.Context, applicationID int64) ([]core.Relationship, error) {
+	return c.Friends(ctx, func(rel *core.Relationship) bool {
+		return rel.Presence.Activity.ApplicationID == applicationID
+	})
+}
+
+// FriendsInGame returns the friends currently playing this game
+func (c *RelationshipClient) FriendsInGame(ctx context.Context) ([]core.Relationship, error) {
+	if c.applicationID == 0 {
+		return nil, fmt.Errorf("application ID not available")
+	}
+	return c.FriendsPlaying(ctx, c.applicationID)
+}
+
+// ByType returns the relationships of the given type
+func (c *RelationshipClient) ByType(ctx context.Context, relType core.RelationshipType) ([]core.Relationship, error) {
+	return c.Relationships(ctx, func(rel *core.Relationship) bool {
+		return rel.Type == relType
+	})
+}
+
+// BlockedUsers returns the users the current user has blocked
+func (c *RelationshipClient) BlockedUsers(ctx context.Context) ([]core.User, error) {
+	rels, err := c.ByType(ctx, core.RelationshipTypeBlocked)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]core.User, 0, len(rels))
+	for _, rel := range rels {
+		users = append(users, rel.User)
+	}
+	return users, nil
 }
@@ -0,0 +1,55 @@
+package discord
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleRelationshipClient_Get demonstrates how to look up the relationship with a user.
+// This example is for documentation only and requires a real, initialized Client.
+func ExampleRelationshipClient_Get() {
+	var client *Client // Assume this is properly initialized
+	var friendID int64 // Assume this is a real user ID
+
+	count, err := client.Relationship().Count()
+	if err != nil {
+		log.Fatalf("failed to count relationships: %v", err)
+	}
+	log.Printf("You have %d relationships", count)
+
+	rel, err := client.Relationship().Get(friendID)
+	if err != nil {
+		log.Fatalf("failed to get relationship: %v", err)
+	}
+	log.Printf("%s is %v", rel.User.Username, rel.Presence.Status)
+	// No Output: (documentation only)
+}
+
+// ExampleRelationshipClient_Friends demonstrates how to list friends with a Go predicate.
+// This example is for documentation only and requires a real, initialized Client.
+func ExampleRelationshipClient_Friends() {
+	var client *Client // Assume this is properly initialized
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	streaming, err := client.Relationship().Friends(ctx, func(rel *core.Relationship) bool {
+		return rel.Presence.Activity.Type == core.ActivityTypeStreaming
+	})
+	if err != nil {
+		log.Fatalf("failed to list friends: %v", err)
+	}
+	log.Printf("%d friends are streaming", len(streaming))
+
+	inGame, err := client.Relationship().FriendsInGame(ctx)
+	if err != nil {
+		log.Fatalf("failed to list friends in game: %v", err)
+	}
+	for _, rel := range inGame {
+		log.Printf("%s is playing: %s", rel.User.Username, rel.Presence.Activity.State)
+	}
+	// No Output: (documentation only)
+}
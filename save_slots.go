@@ -0,0 +1,343 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	discordlog "github.com/andresperezl/discordgamesdk-go/discordlog"
+)
+
+// SaveVersion describes one stored version of a save slot
+type SaveVersion struct {
+	Slot       string
+	Generation uint64
+	Name       string    // storage file holding this version
+	Size       uint64    // size of the saved data; 0 if a sealed version cannot be read
+	SavedAt    time.Time // from core.FileStat.LastModified
+	Current    bool
+}
+
+// SaveSlots stores named save slots on top of cloud storage so that an interrupted
+// write never replaces a good save.
+//
+// Every save is written to a new generation file named "<slot>.v<generation>",
+// which acts as the temporary file: it is read back and verified before the small
+// head file "<slot>" is swapped to point at it. A crash before the swap leaves the
+// previous save current. The newest Keep previous versions are retained for Rollback.
+//
+// Generation files end with a SHA-256 checksum of the save. If the head is missing,
+// damaged by a crash during the swap, or points at a version that fails its checksum,
+// Load falls back to the newest version that verifies.
+type SaveSlots struct {
+	storage *StorageClient
+	keep    int
+
+	mu sync.Mutex
+}
+
+// SaveSlots returns a save-slot layer that keeps up to keep previous versions of every slot
+//
+// Example usage:
+//
+//	slots := client.Storage().SaveSlots(3)
+//	if err := slots.Save(ctx, "slot1", data); err != nil {
+//	    log.Fatalf("failed to save: %v", err)
+//	}
+//	data, err := slots.Load("slot1")
+func (sc *StorageClient) SaveSlots(keep int) *SaveSlots {
+	if keep < 0 {
+		keep = 0
+	}
+	return &SaveSlots{storage: sc, keep: keep}
+}
+
+// Save writes data as the new current version of slot, respecting context cancellation and timeout.
+//
+// Returns an error if the context is cancelled, deadline exceeded, the write fails, or the
+// written file does not read back identically. The previous version stays current on error.
+// Old versions that cannot be pruned once the new version is current are logged and
+// retried by the next Save.
+func (s *SaveSlots) Save(ctx context.Context, slot string, data []byte) error {
+	if err := validateSlotName(slot); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	versions, err := s.versions(slot)
+	if err != nil {
+		return err
+	}
+	generation := uint64(1)
+	if len(versions) > 0 {
+		generation = versions[len(versions)-1].Generation + 1
+	}
+	name := slotVersionName(slot, generation)
+	sealed := sealSaveVersion(data)
+
+	if err := s.storage.WriteWithContext(ctx, name, sealed); err != nil {
+		return err
+	}
+	written, err := s.storage.ReadAll(name)
+	if err != nil || !bytes.Equal(written, sealed) {
+		_ = s.storage.Delete(name)
+		if err != nil {
+			return fmt.Errorf("failed to verify save slot %q: %v", slot, err)
+		}
+		return fmt.Errorf("failed to verify save slot %q: contents differ after write", slot)
+	}
+
+	if err := s.storage.WriteWithContext(ctx, slot, []byte(strconv.FormatUint(generation, 10))); err != nil {
+		return err
+	}
+	if err := s.prune(slot, generation); err != nil {
+		discordlog.GetLogger().Warn("SaveSlots.Save: failed to prune old versions", "slot", slot, "error", err)
+	}
+	return nil
+}
+
+// Load reads the current version of slot, or the newest version that verifies if
+// the head is missing or damaged
+func (s *SaveSlots) Load(slot string) ([]byte, error) {
+	if err := validateSlotName(slot); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, data, err := s.resolve(slot)
+	return data, err
+}
+
+// Versions returns the stored versions of slot ordered from oldest to newest
+func (s *SaveSlots) Versions(slot string) ([]SaveVersion, error) {
+	if err := validateSlotName(slot); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	versions, err := s.versions(slot)
+	if err != nil {
+		return nil, err
+	}
+	if generation, _, err := s.resolve(slot); err == nil {
+		for i := range versions {
+			versions[i].Current = versions[i].Generation == generation
+		}
+	}
+	if s.storage.sealing() {
+		// the stored size includes the envelope or encryption, so read the save to size it
+		for i := range versions {
+			versions[i].Size = 0
+			if data, err := s.readVersion(slot, versions[i].Generation); err == nil {
+				versions[i].Size = uint64(len(data))
+			}
+		}
+	}
+	return versions, nil
+}
+
+// Rollback makes a previous version of slot current again, respecting context cancellation and timeout.
+// Versions newer than the restored one are kept until they are pruned by later saves.
+func (s *SaveSlots) Rollback(ctx context.Context, slot string, generation uint64) error {
+	if err := validateSlotName(slot); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exists, err := s.storage.Exists(slotVersionName(slot, generation))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("save slot %q has no version %d", slot, generation)
+	}
+	if _, err := s.readVersion(slot, generation); err != nil {
+		return err
+	}
+	return s.storage.WriteWithContext(ctx, slot, []byte(strconv.FormatUint(generation, 10)))
+}
+
+// resolve returns the generation and contents Load should use: the one the head points
+// at if it verifies, otherwise the newest version that does
+func (s *SaveSlots) resolve(slot string) (uint64, []byte, error) {
+	generation, headErr := s.current(slot)
+	if headErr == nil {
+		data, err := s.readVersion(slot, generation)
+		if err == nil {
+			return generation, data, nil
+		}
+		headErr = err
+	}
+
+	versions, err := s.versions(slot)
+	if err != nil {
+		return 0, nil, err
+	}
+	for i := len(versions) - 1; i >= 0; i-- {
+		if data, err := s.readVersion(slot, versions[i].Generation); err == nil {
+			return versions[i].Generation, data, nil
+		}
+	}
+	return 0, nil, headErr
+}
+
+// readVersion reads one generation of slot and checks its checksum
+func (s *SaveSlots) readVersion(slot string, generation uint64) ([]byte, error) {
+	sealed, err := s.storage.ReadAll(slotVersionName(slot, generation))
+	if err != nil {
+		return nil, err
+	}
+	data, err := openSaveVersion(sealed)
+	if err != nil {
+		return nil, fmt.Errorf("save slot %q version %d: %w", slot, generation, err)
+	}
+	return data, nil
+}
+
+// current reads the generation the head file of slot points at
+func (s *SaveSlots) current(slot string) (uint64, error) {
+	exists, err := s.storage.Exists(slot)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, fmt.Errorf("save slot %q not found", slot)
+	}
+	head, err := s.storage.ReadAll(slot)
+	if err != nil {
+		return 0, err
+	}
+	generation, err := strconv.ParseUint(strings.TrimSpace(string(head)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("save slot %q has a corrupt head: %v", slot, err)
+	}
+	return generation, nil
+}
+
+// versions lists the generation files of slot ordered by generation
+func (s *SaveSlots) versions(slot string) ([]SaveVersion, error) {
+	stats, err := s.storage.List()
+	if err != nil {
+		return nil, err
+	}
+	var versions []SaveVersion
+	for _, stat := range stats {
+		generation, ok := parseSlotVersionName(slot, stat.Filename)
+		if !ok {
+			continue
+		}
+		versions = append(versions, SaveVersion{
+			Slot:       slot,
+			Generation: generation,
+			Name:       stat.Filename,
+			Size:       saveVersionSize(stat.Size),
+			SavedAt:    time.Unix(int64(stat.LastModified), 0),
+		})
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Generation < versions[j].Generation })
+	return versions, nil
+}
+
+// prune deletes all but the newest keep versions older than the current one, returning
+// the first error after attempting every deletion
+func (s *SaveSlots) prune(slot string, current uint64) error {
+	versions, err := s.versions(slot)
+	if err != nil {
+		return err
+	}
+	var firstErr error
+	kept := 0
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].Generation >= current {
+			continue
+		}
+		if kept < s.keep {
+			kept++
+			continue
+		}
+		if err := s.storage.Delete(versions[i].Name); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// validateSlotName rejects names that would collide with the generation files of
+// another slot, such as "a.v3" with version 3 of slot "a"
+func validateSlotName(slot string) error {
+	if slot == "" {
+		return fmt.Errorf("save slot name must not be empty")
+	}
+	if i := strings.LastIndex(slot, ".v"); i >= 0 {
+		if _, err := strconv.ParseUint(slot[i+2:], 10, 64); err == nil {
+			return fmt.Errorf("save slot name %q must not end in .v<number>", slot)
+		}
+	}
+	return nil
+}
+
+// saveVersionMagic marks the checksum trailer of a generation file
+const saveVersionMagic = "DSV1"
+
+const saveVersionTrailerSize = len(saveVersionMagic) + sha256.Size
+
+// errSaveVersionCorrupt is returned for generation files that fail their checksum
+var errSaveVersionCorrupt = errors.New("save version is corrupt")
+
+// sealSaveVersion appends the checksum trailer to a save
+func sealSaveVersion(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	sealed := make([]byte, 0, len(data)+saveVersionTrailerSize)
+	sealed = append(sealed, data...)
+	sealed = append(sealed, saveVersionMagic...)
+	return append(sealed, sum[:]...)
+}
+
+// openSaveVersion verifies and strips the checksum trailer of a generation file
+func openSaveVersion(sealed []byte) ([]byte, error) {
+	if len(sealed) < saveVersionTrailerSize {
+		return nil, errSaveVersionCorrupt
+	}
+	data := sealed[:len(sealed)-saveVersionTrailerSize]
+	trailer := sealed[len(data):]
+	sum := sha256.Sum256(data)
+	if string(trailer[:len(saveVersionMagic)]) != saveVersionMagic || !bytes.Equal(trailer[len(saveVersionMagic):], sum[:]) {
+		return nil, errSaveVersionCorrupt
+	}
+	return data, nil
+}
+
+// saveVersionSize returns the size of the save held in a generation file of the given size
+func saveVersionSize(fileSize uint64) uint64 {
+	if fileSize < uint64(saveVersionTrailerSize) {
+		return 0
+	}
+	return fileSize - uint64(saveVersionTrailerSize)
+}
+
+func slotVersionName(slot string, generation uint64) string {
+	return slot + ".v" + strconv.FormatUint(generation, 10)
+}
+
+func parseSlotVersionName(slot, name string) (uint64, bool) {
+	suffix, ok := strings.CutPrefix(name, slot+".v")
+	if !ok || suffix == "" {
+		return 0, false
+	}
+	generation, err := strconv.ParseUint(suffix, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return generation, true
+}
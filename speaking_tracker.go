@@ -0,0 +1,263 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// defaultSpeakingHold is used when SpeakingTrackerOptions.Hold is not set
+const defaultSpeakingHold = 250 * time.Millisecond
+
+// SpeakingTrackerOptions configures a SpeakingTracker
+type SpeakingTrackerOptions struct {
+	// Hold is how long a user keeps being reported as speaking after Discord says
+	// they stopped, so short pauses do not flap the indicator. Defaults to 250ms.
+	Hold time.Duration
+}
+
+// SpeakingChange is emitted when a user starts or stops speaking, after debouncing
+type SpeakingChange struct {
+	UserID   int64
+	Speaking bool
+	At       time.Time
+}
+
+// SpeakingTracker aggregates the raw OnSpeaking events of one lobby into a
+// debounced speaking state per user. It is safe for concurrent use.
+type SpeakingTracker struct {
+	lobbyID int64
+	hold    time.Duration
+	now     func() time.Time
+	after   func(d time.Duration, f func()) (stop func() bool)
+
+	unsubscribe func()
+
+	mu      sync.Mutex
+	users   map[int64]*speakerState
+	changes broadcaster[SpeakingChange]
+	closed  bool
+}
+
+type speakerState struct {
+	speaking  bool
+	lastSpoke time.Time
+	stop      func() bool // cancels the pending stop, nil when none is pending
+	gen       uint64      // invalidates stops that fire after being cancelled
+}
+
+// TrackSpeaking returns a tracker fed by the OnSpeaking and OnMemberDisconnect events
+// of lobbyID. It adds its own subscription to the lobby events, so it can be used
+// alongside LobbyEventsChannel and other trackers.
+//
+// Example usage:
+//
+//	tracker, err := client.Lobby().TrackSpeaking(lobbyID, discord.SpeakingTrackerOptions{})
+//	if err != nil {
+//	    log.Fatalf("failed to track speaking: %v", err)
+//	}
+//	defer tracker.Close()
+//	go func() {
+//	    for change := range tracker.Changes() {
+//	        nameplates.SetTalking(change.UserID, change.Speaking)
+//	    }
+//	}()
+//
+// Returns an error if the lobby events are not available.
+func (c *LobbyClient) TrackSpeaking(lobbyID int64, opts SpeakingTrackerOptions) (*SpeakingTracker, error) {
+	if c.core == nil {
+		return nil, fmt.Errorf("lobby events not available")
+	}
+	t := NewSpeakingTracker(lobbyID, opts)
+	t.listen(c.core)
+	return t, nil
+}
+
+// NewSpeakingTracker creates a tracker for lobbyID that is fed manually with Run or Handle.
+// Use LobbyClient.TrackSpeaking to feed it from the lobby events.
+func NewSpeakingTracker(lobbyID int64, opts SpeakingTrackerOptions) *SpeakingTracker {
+	if opts.Hold <= 0 {
+		opts.Hold = defaultSpeakingHold
+	}
+	return &SpeakingTracker{
+		lobbyID: lobbyID,
+		hold:    opts.Hold,
+		now:     time.Now,
+		after: func(d time.Duration, f func()) func() bool {
+			return time.AfterFunc(d, f).Stop
+		},
+		users: make(map[int64]*speakerState),
+	}
+}
+
+// Run handles events, such as LobbyEventChannels.Speaking, until ctx is done or events is closed
+func (t *SpeakingTracker) Run(ctx context.Context, events <-chan SpeakingEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			t.Handle(event)
+		}
+	}
+}
+
+// listen feeds the tracker from the lobby events of source until Close. The handlers run
+// inline on the SDK thread; the tracker never waits on the SDK while holding t.mu.
+func (t *SpeakingTracker) listen(source eventSource) {
+	t.unsubscribe = source.AddLobbyEvents(&core.LobbyEvents{
+		OnSpeaking: func(lobbyID, userID int64, speaking bool) {
+			t.Handle(SpeakingEvent{LobbyID: lobbyID, UserID: userID, Speaking: speaking})
+		},
+		OnMemberDisconnect: func(lobbyID, userID int64) {
+			if lobbyID == t.lobbyID {
+				t.Remove(userID)
+			}
+		},
+	})
+}
+
+// Handle processes a raw speaking event. Events for other lobbies are ignored.
+func (t *SpeakingTracker) Handle(event SpeakingEvent) {
+	if event.LobbyID != t.lobbyID {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return
+	}
+
+	st, ok := t.users[event.UserID]
+	if !ok {
+		st = &speakerState{}
+		t.users[event.UserID] = st
+	}
+	now := t.now()
+
+	if event.Speaking {
+		st.lastSpoke = now
+		if st.stop != nil {
+			st.stop()
+			st.stop = nil
+			st.gen++
+			return
+		}
+		if !st.speaking {
+			st.speaking = true
+			t.emit(SpeakingChange{UserID: event.UserID, Speaking: true, At: now})
+		}
+		return
+	}
+
+	if !st.speaking || st.stop != nil {
+		return
+	}
+	st.lastSpoke = now
+	st.gen++
+	gen := st.gen
+	userID := event.UserID
+	st.stop = t.after(t.hold, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if t.closed || st.gen != gen {
+			return
+		}
+		st.speaking = false
+		st.stop = nil
+		t.emit(SpeakingChange{UserID: userID, Speaking: false, At: t.now()})
+	})
+}
+
+// Remove forgets a user, for example after they leave the lobby, reporting them as
+// no longer speaking if needed. Their last-spoke time is kept.
+func (t *SpeakingTracker) Remove(userID int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	st, ok := t.users[userID]
+	if !ok || !st.speaking {
+		return
+	}
+	if st.stop != nil {
+		st.stop()
+		st.stop = nil
+	}
+	st.gen++
+	st.speaking = false
+	if !t.closed {
+		t.emit(SpeakingChange{UserID: userID, Speaking: false, At: t.now()})
+	}
+}
+
+// IsSpeaking reports whether a user is currently speaking
+func (t *SpeakingTracker) IsSpeaking(userID int64) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	st, ok := t.users[userID]
+	return ok && st.speaking
+}
+
+// Speaking returns the IDs of the users currently speaking, sorted
+func (t *SpeakingTracker) Speaking() []int64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var speaking []int64
+	for userID, st := range t.users {
+		if st.speaking {
+			speaking = append(speaking, userID)
+		}
+	}
+	sort.Slice(speaking, func(i, j int) bool { return speaking[i] < speaking[j] })
+	return speaking
+}
+
+// LastSpoke returns the last time a user was heard speaking
+func (t *SpeakingTracker) LastSpoke(userID int64) (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	st, ok := t.users[userID]
+	if !ok || st.lastSpoke.IsZero() {
+		return time.Time{}, false
+	}
+	return st.lastSpoke, true
+}
+
+// Changes returns a channel that receives every debounced speaking change from now on.
+// Each call returns a new channel; all channels are closed by Close.
+func (t *SpeakingTracker) Changes() <-chan SpeakingChange {
+	return t.changes.subscribe(8)
+}
+
+// Close stops pending timers, unsubscribes from the lobby events and closes all
+// channels returned by Changes. Other subscribers to the lobby events are not affected.
+func (t *SpeakingTracker) Close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return
+	}
+	t.closed = true
+	if t.unsubscribe != nil {
+		t.unsubscribe()
+	}
+	for _, st := range t.users {
+		if st.stop != nil {
+			st.stop()
+			st.stop = nil
+		}
+	}
+	t.changes.close()
+}
+
+// emit delivers a change to every subscriber without blocking. Callers must hold t.mu
+// so changes are delivered in the order they happen.
+func (t *SpeakingTracker) emit(change SpeakingChange) {
+	t.changes.emit(change)
+}
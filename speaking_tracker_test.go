@@ -0,0 +1,76 @@
+package discord
+
+import (
+	"fmt"
+	"time"
+)
+
+// ExampleSpeakingTracker demonstrates that short pauses do not flap the speaking state.
+func ExampleSpeakingTracker() {
+	const lobbyID = 7
+	t := NewSpeakingTracker(lobbyID, SpeakingTrackerOptions{})
+	clock := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	t.now = func() time.Time { return clock }
+	var pending []func()
+	t.after = func(d time.Duration, f func()) func() bool {
+		pending = append(pending, f)
+		return func() bool { return true }
+	}
+	fire := func() {
+		for _, f := range pending {
+			f()
+		}
+		pending = nil
+	}
+	changes := t.Changes()
+
+	t.Handle(SpeakingEvent{LobbyID: lobbyID, UserID: 1, Speaking: true})
+	t.Handle(SpeakingEvent{LobbyID: lobbyID, UserID: 1, Speaking: false})
+	t.Handle(SpeakingEvent{LobbyID: lobbyID, UserID: 1, Speaking: true}) // resumed within the hold
+	fire()
+	fmt.Println("speaking:", t.Speaking())
+
+	clock = clock.Add(2 * time.Second)
+	t.Handle(SpeakingEvent{LobbyID: lobbyID, UserID: 1, Speaking: false})
+	fire()
+	last, _ := t.LastSpoke(1)
+	fmt.Println("speaking:", t.Speaking(), "last spoke:", last.Format(time.TimeOnly))
+	t.Close()
+
+	for change := range changes {
+		fmt.Println(change.UserID, change.Speaking)
+	}
+	// Output:
+	// speaking: [1]
+	// speaking: [] last spoke: 12:00:02
+	// 1 true
+	// 1 false
+}
+
+// ExampleSpeakingTracker_events demonstrates a tracker fed by the OnSpeaking and
+// OnMemberDisconnect events of its lobby.
+func ExampleSpeakingTracker_events() {
+	const lobbyID = 7
+	events := &testEvents{}
+	t := NewSpeakingTracker(lobbyID, SpeakingTrackerOptions{})
+	t.listen(events)
+	changes := t.Changes()
+
+	events.speaking(lobbyID, 1, true)
+	events.speaking(lobbyID+1, 2, true) // another lobby
+	fmt.Println("speaking:", t.Speaking())
+
+	events.memberDisconnect(lobbyID, 1)
+	t.Close()
+	events.speaking(lobbyID, 3, true) // after Close
+
+	for change := range changes {
+		fmt.Println(change.UserID, change.Speaking)
+	}
+	fmt.Println("speaking:", t.Speaking())
+	// Output:
+	// speaking: [1]
+	// 1 true
+	// 1 false
+	// speaking: []
+}
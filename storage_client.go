@@ -2,15 +2,39 @@ package discord
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	core "github.com/andresperezl/discordgamesdk-go/core"
 )
 
+// storageManager is the part of core.StorageManager used by StorageClient
+type storageManager interface {
+	Read(name string, data []byte) (int, core.Result)
+	ReadAsync(name string, callback func(result core.Result, data []byte))
+	ReadAsyncPartial(name string, offset, length uint64, callback func(result core.Result, data []byte))
+	Write(name string, data []byte) core.Result
+	WriteAsync(name string, data []byte, callback func(result core.Result))
+	Delete(name string) core.Result
+	Exists(name string) (bool, core.Result)
+	Count() (int32, core.Result)
+	Stat(name string) (*core.FileStat, core.Result)
+	StatAt(index int32) (*core.FileStat, core.Result)
+	GetPath() (string, core.Result)
+}
+
+// ErrSealedRangedRead is returned by ranged reads on a storage client that stores files
+// in an envelope or encrypted, because a byte range of a sealed file cannot be unsealed
+var ErrSealedRangedRead = errors.New("ranged reads are not supported on sealed storage")
+
 // StorageClient provides Go-like interfaces for storage management
 type StorageClient struct {
-	manager *core.StorageManager
-	core    *core.Core
+	manager  storageManager
+	core     *core.Core
+	envelope *EnvelopeOptions // set by WithEnvelope
+	key      []byte           // set by WithEncryption
+	quota    uint64           // set by WithQuota
 }
 
 // Read reads data from storage
@@ -23,7 +47,7 @@ func (sc *StorageClient) Read(name string) ([]byte, error) {
 	if result != core.ResultOk {
 		return nil, fmt.Errorf("failed to read: %v", result)
 	}
-	return buf[:n], nil
+	return sc.unseal(name, buf[:n])
 }
 
 // ReadAsync reads data from storage asynchronously
@@ -43,6 +67,13 @@ func (sc *StorageClient) ReadAsync(name string) (<-chan []byte, <-chan error) {
 			close(errChan)
 			return
 		}
+		data, err := sc.unseal(name, data)
+		if err != nil {
+			errChan <- err
+			close(dataChan)
+			close(errChan)
+			return
+		}
 		dataChan <- data
 		close(dataChan)
 		close(errChan)
@@ -55,6 +86,13 @@ func (sc *StorageClient) Write(name string, data []byte) error {
 	if sc.manager == nil {
 		return fmt.Errorf("storage manager not available")
 	}
+	data, err := sc.seal(name, data)
+	if err != nil {
+		return err
+	}
+	if err := sc.checkQuota(name, len(data)); err != nil {
+		return err
+	}
 	result := sc.manager.Write(name, data)
 	if result != core.ResultOk {
 		return fmt.Errorf("failed to write: %v", result)
@@ -70,6 +108,17 @@ func (sc *StorageClient) WriteAsync(name string, data []byte) <-chan error {
 		close(errChan)
 		return errChan
 	}
+	data, err := sc.seal(name, data)
+	if err != nil {
+		errChan <- err
+		close(errChan)
+		return errChan
+	}
+	if err := sc.checkQuota(name, len(data)); err != nil {
+		errChan <- err
+		close(errChan)
+		return errChan
+	}
 	sc.manager.WriteAsync(name, data, func(result core.Result) {
 		if result != core.ResultOk {
 			errChan <- fmt.Errorf("failed to write async: %v", result)
@@ -97,6 +146,13 @@ func (sc *StorageClient) WriteWithContext(ctx context.Context, name string, data
 	if sc.manager == nil {
 		return fmt.Errorf("storage manager not available")
 	}
+	data, err := sc.seal(name, data)
+	if err != nil {
+		return err
+	}
+	if err := sc.checkQuota(name, len(data)); err != nil {
+		return err
+	}
 	errChan := make(chan error, 1)
 
 	sc.manager.WriteAsync(name, data, func(result core.Result) {
@@ -200,6 +256,63 @@ func (sc *StorageClient) ReadWithContext(ctx context.Context, name string) ([]by
 			errChan <- fmt.Errorf("failed to read async: %v", result)
 			return
 		}
+		data, err := sc.unseal(name, data)
+		if err != nil {
+			errChan <- err
+			return
+		}
+		dataChan <- data
+	})
+
+	select {
+	case data := <-dataChan:
+		return data, nil
+	case err := <-errChan:
+		return nil, err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// ReadAt reads up to n bytes starting at offset off from a file in storage, respecting context cancellation and timeout.
+// Only the requested range is transferred, so large files do not need to be read in full.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+//	defer cancel()
+//	header, err := client.Storage().ReadAt(ctx, "archive.sav", 0, 512)
+//	if err != nil {
+//	    log.Fatalf("failed to read header: %v", err)
+//	}
+//
+// Returns the data or error if the context is cancelled, deadline exceeded, or the read fails.
+// The returned slice is shorter than n when the range extends past the end of the file.
+// Returns ErrSealedRangedRead if the client uses WithEnvelope or WithEncryption.
+func (sc *StorageClient) ReadAt(ctx context.Context, name string, off int64, n int) ([]byte, error) {
+	if sc.manager == nil {
+		return nil, fmt.Errorf("storage manager not available")
+	}
+	if sc.sealing() {
+		return nil, ErrSealedRangedRead
+	}
+	if off < 0 {
+		return nil, fmt.Errorf("invalid offset: %d", off)
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("invalid length: %d", n)
+	}
+	if n == 0 {
+		return []byte{}, nil
+	}
+	dataChan := make(chan []byte, 1)
+	errChan := make(chan error, 1)
+
+	sc.manager.ReadAsyncPartial(name, uint64(off), uint64(n), func(result core.Result, data []byte) {
+		if result != core.ResultOk {
+			errChan <- fmt.Errorf("failed to read partial: %v", result)
+			return
+		}
 		dataChan <- data
 	})
 
@@ -212,3 +325,53 @@ func (sc *StorageClient) ReadWithContext(ctx context.Context, name string) ([]by
 		return nil, ctx.Err()
 	}
 }
+
+// StorageReaderAt implements io.ReaderAt over a single file in storage using ranged reads
+type StorageReaderAt struct {
+	sc   *StorageClient
+	ctx  context.Context
+	name string
+}
+
+var _ io.ReaderAt = (*StorageReaderAt)(nil)
+
+// ReaderAt returns an io.ReaderAt for the named file. Every read uses ctx for cancellation.
+// Reads and Size return ErrSealedRangedRead if the client uses WithEnvelope or WithEncryption.
+//
+// Example usage:
+//
+//	r := client.Storage().ReaderAt(ctx, "archive.sav")
+//	size, err := r.Size()
+//	if err != nil {
+//	    log.Fatalf("failed to stat archive: %v", err)
+//	}
+//	zr, err := zip.NewReader(r, size)
+func (sc *StorageClient) ReaderAt(ctx context.Context, name string) *StorageReaderAt {
+	return &StorageReaderAt{sc: sc, ctx: ctx, name: name}
+}
+
+// ReadAt reads len(p) bytes from the file starting at offset off.
+// It returns io.EOF when fewer than len(p) bytes are available.
+func (r *StorageReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	data, err := r.sc.ReadAt(r.ctx, r.name, off, len(p))
+	if err != nil {
+		return 0, err
+	}
+	n := copy(p, data)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Size returns the size of the file in bytes
+func (r *StorageReaderAt) Size() (int64, error) {
+	if r.sc.sealing() {
+		return 0, ErrSealedRangedRead
+	}
+	stat, err := r.sc.Stat(r.name)
+	if err != nil {
+		return 0, err
+	}
+	return int64(stat.Size), nil
+}
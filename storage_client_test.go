@@ -1,9 +1,20 @@
 package discord
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"io/fs"
 	"log"
+	"maps"
+	"math"
+	"slices"
+	"sync"
+	"testing/fstest"
 	"time"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
 )
 
 // ExampleStorageClient_WriteWithContext demonstrates how to use WriteWithContext with a timeout.
@@ -37,6 +48,217 @@ func ExampleStorageClient_ReadWithContext() {
 	// No Output: (documentation only)
 }
 
+// ExampleStorageClient_ReadAt demonstrates how to read a byte range of a large file without reading all of it.
+// This example is for documentation only and requires a real, initialized StorageClient.
+func ExampleStorageClient_ReadAt() {
+	var storageClient *StorageClient // Assume this is properly initialized
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	header, err := storageClient.ReadAt(ctx, "archive.sav", 0, 512)
+	if err != nil {
+		log.Fatalf("failed to read header: %v", err)
+	}
+	log.Printf("Read %d header bytes", len(header))
+
+	r := storageClient.ReaderAt(ctx, "archive.sav")
+	size, err := r.Size()
+	if err != nil {
+		log.Fatalf("failed to stat archive: %v", err)
+	}
+	section := io.NewSectionReader(r, size-1024, 1024)
+	trailer, err := io.ReadAll(section)
+	if err != nil {
+		log.Fatalf("failed to read trailer: %v", err)
+	}
+	log.Printf("Read %d trailer bytes", len(trailer))
+	// No Output: (documentation only)
+}
+
+// ExampleStorageClient_ReadAt_sealed shows ranged reads of a stored file, and that they
+// are refused on a client that seals files, whose stored bytes differ from the contents.
+func ExampleStorageClient_ReadAt_sealed() {
+	ctx := context.Background()
+	storage := &StorageClient{manager: newMemStorage()}
+	_ = storage.Write("archive.sav", []byte("header:payload"))
+	data, err := storage.ReadAt(ctx, "archive.sav", 7, 32)
+	fmt.Println(string(data), err)
+	size, err := storage.ReaderAt(ctx, "archive.sav").Size()
+	fmt.Println(size, err)
+
+	sealed := storage.WithEnvelope(EnvelopeOptions{})
+	_, err = sealed.ReadAt(ctx, "archive.sav", 0, 6)
+	fmt.Println(err)
+	_, err = sealed.ReaderAt(ctx, "archive.sav").Size()
+	fmt.Println(err)
+	// Output:
+	// payload <nil>
+	// 14 <nil>
+	// ranged reads are not supported on sealed storage
+	// ranged reads are not supported on sealed storage
+}
+
+// ExampleStorageClient_FS demonstrates how to browse cloud storage with io/fs and write through an io.WriteCloser.
+// This example is for documentation only and requires a real, initialized StorageClient.
+func ExampleStorageClient_FS() {
+	var storageClient *StorageClient // Assume this is properly initialized
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	w := storageClient.NewWriter(ctx, "saves/slot1.sav")
+	if _, err := io.WriteString(w, "level=3"); err != nil {
+		log.Fatalf("failed to buffer save: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		log.Fatalf("failed to write save: %v", err)
+	}
+
+	err := fs.WalkDir(storageClient.FS(), ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			log.Printf("Found %s", path)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatalf("failed to walk storage: %v", err)
+	}
+	// No Output: (documentation only)
+}
+
+// ExampleStorageClient_SaveSlots demonstrates how to save and roll back a versioned save slot.
+// This example is for documentation only and requires a real, initialized StorageClient.
+func ExampleStorageClient_SaveSlots() {
+	var storageClient *StorageClient // Assume this is properly initialized
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	slots := storageClient.SaveSlots(3)
+	if err := slots.Save(ctx, "slot1", []byte("level=4")); err != nil {
+		log.Fatalf("failed to save: %v", err)
+	}
+
+	versions, err := slots.Versions("slot1")
+	if err != nil {
+		log.Fatalf("failed to list versions: %v", err)
+	}
+	for _, v := range versions {
+		log.Printf("Generation %d saved at %s (current=%v)", v.Generation, v.SavedAt, v.Current)
+	}
+	if len(versions) > 1 {
+		previous := versions[len(versions)-2]
+		if err := slots.Rollback(ctx, "slot1", previous.Generation); err != nil {
+			log.Fatalf("failed to roll back: %v", err)
+		}
+	}
+	// No Output: (documentation only)
+}
+
+// ExampleSaveSlots_Save_integrity shows the slot names Save rejects and how damaged
+// versions are detected so Load can fall back to an older one.
+func ExampleSaveSlots_Save_integrity() {
+	for _, name := range []string{"slot1", "a.v3", "a.v", "autosave.v2.bak"} {
+		fmt.Println(name, validateSlotName(name) == nil)
+	}
+
+	sealed := sealSaveVersion([]byte("level=4"))
+	data, err := openSaveVersion(sealed)
+	fmt.Println(string(data), err)
+
+	sealed[0] ^= 1
+	_, err = openSaveVersion(sealed)
+	fmt.Println(err)
+	_, err = openSaveVersion(sealed[:10])
+	fmt.Println(err)
+	// Output:
+	// slot1 true
+	// a.v3 false
+	// a.v true
+	// autosave.v2.bak true
+	// level=4 <nil>
+	// save version is corrupt
+	// save version is corrupt
+}
+
+// ExampleSaveSlots_Rollback saves three versions of a slot with one previous version
+// kept, then rolls back to it.
+func ExampleSaveSlots_Rollback() {
+	ctx := context.Background()
+	slots := (&StorageClient{manager: newMemStorage()}).SaveSlots(1)
+	for _, save := range []string{"level=1", "level=2", "level=3"} {
+		if err := slots.Save(ctx, "slot1", []byte(save)); err != nil {
+			fmt.Println(err)
+		}
+	}
+	versions, _ := slots.Versions("slot1")
+	for _, v := range versions {
+		fmt.Println(v.Name, v.Size, v.Current)
+	}
+
+	fmt.Println(slots.Rollback(ctx, "slot1", 2))
+	data, err := slots.Load("slot1")
+	fmt.Println(string(data), err)
+	fmt.Println(slots.Rollback(ctx, "slot1", 1))
+	// Output:
+	// slot1.v2 7 false
+	// slot1.v3 7 true
+	// <nil>
+	// level=2 <nil>
+	// save slot "slot1" has no version 1
+}
+
+// ExampleSaveSlots_Load_fallback shows Load falling back to the newest version that
+// verifies when the head or the current version is damaged.
+func ExampleSaveSlots_Load_fallback() {
+	ctx := context.Background()
+	storage := newMemStorage()
+	slots := (&StorageClient{manager: storage}).SaveSlots(3)
+	_ = slots.Save(ctx, "slot1", []byte("level=1"))
+	_ = slots.Save(ctx, "slot1", []byte("level=2"))
+
+	storage.Write("slot1", []byte("\x00garbage"))
+	data, err := slots.Load("slot1")
+	fmt.Println(string(data), err)
+
+	storage.files["slot1.v2"][0] ^= 1
+	data, err = slots.Load("slot1")
+	fmt.Println(string(data), err)
+	// Output:
+	// level=2 <nil>
+	// level=1 <nil>
+}
+
+// ExampleSaveSlots_Save_prune shows that a version that cannot be pruned does not fail
+// Save, and is pruned by a later Save. Sizes are those of the saves, not of the sealed files.
+func ExampleSaveSlots_Save_prune() {
+	ctx := context.Background()
+	storage := newMemStorage()
+	slots := (&StorageClient{manager: storage}).WithEnvelope(EnvelopeOptions{Compression: EnvelopeCompressionGzip}).SaveSlots(0)
+	_ = slots.Save(ctx, "slot1", []byte("level=1"))
+
+	storage.deleteResult = core.ResultLockFailed
+	fmt.Println(slots.Save(ctx, "slot1", []byte("level=2")))
+	versions, _ := slots.Versions("slot1")
+	fmt.Println(len(versions))
+
+	storage.deleteResult = core.ResultOk
+	fmt.Println(slots.Save(ctx, "slot1", []byte("level=3")))
+	versions, _ = slots.Versions("slot1")
+	for _, v := range versions {
+		fmt.Println(v.Name, v.Size, v.Current)
+	}
+	// Output:
+	// <nil>
+	// 2
+	// <nil>
+	// slot1.v3 7 true
+}
+
 // ExampleStoreClient_FetchSkusWithContext demonstrates how to use FetchSkusWithContext with a timeout.
 // This example is for documentation only and requires a real, initialized StoreClient.
 func ExampleStoreClient_FetchSkusWithContext() {
@@ -68,3 +290,136 @@ func ExampleStoreClient_FetchEntitlementsWithContext() {
 	log.Printf("Fetched %d entitlements", len(ents))
 	// No Output: (documentation only)
 }
+
+// ExampleStorageFS_virtualDirectories demonstrates how file names containing slashes are
+// listed as virtual directories, and checks the file system with testing/fstest.
+func ExampleStorageFS_virtualDirectories() {
+	storage := &StorageClient{manager: newMemStorage()}
+	_ = storage.Write("settings.json", []byte(`{"volume":7}`))
+	_ = storage.Write("saves/slot1.sav", []byte("level=3"))
+	_ = storage.Write("saves/auto/slot2.sav", []byte("level=4"))
+
+	fsys := storage.FS()
+	entries, _ := fs.ReadDir(fsys, "saves")
+	for _, entry := range entries {
+		fmt.Println(entry.Name(), entry.IsDir())
+	}
+	fmt.Println(fstest.TestFS(fsys, "settings.json", "saves/slot1.sav", "saves/auto/slot2.sav"))
+
+	sealed := (&StorageClient{manager: newMemStorage()}).WithEnvelope(EnvelopeOptions{Compression: EnvelopeCompressionGzip})
+	_ = sealed.Write("saves/slot1.sav", []byte("level=3"))
+	info, _ := fs.Stat(sealed.FS(), "saves/slot1.sav")
+	fmt.Println("sealed size:", info.Size())
+	fmt.Println(fstest.TestFS(sealed.FS(), "saves/slot1.sav"))
+	// Output:
+	// auto true
+	// slot1.sav false
+	// <nil>
+	// sealed size: 7
+	// <nil>
+}
+
+// memStorage is an in-memory storageManager for tests. Asynchronous calls complete
+// before they return.
+type memStorage struct {
+	mu           sync.Mutex
+	files        map[string][]byte
+	modified     map[string]uint64
+	clock        uint64
+	deleteResult core.Result // returned by Delete instead of deleting, unless ResultOk
+}
+
+func newMemStorage() *memStorage {
+	return &memStorage{files: map[string][]byte{}, modified: map[string]uint64{}, clock: 1700000000}
+}
+
+func (m *memStorage) Read(name string, data []byte) (int, core.Result) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	file, ok := m.files[name]
+	if !ok {
+		return 0, core.ResultNotFound
+	}
+	return copy(data, file), core.ResultOk
+}
+
+func (m *memStorage) ReadAsync(name string, callback func(result core.Result, data []byte)) {
+	m.ReadAsyncPartial(name, 0, math.MaxUint64, callback)
+}
+
+func (m *memStorage) ReadAsyncPartial(name string, offset, length uint64, callback func(result core.Result, data []byte)) {
+	m.mu.Lock()
+	file, ok := m.files[name]
+	m.mu.Unlock()
+	if !ok {
+		callback(core.ResultNotFound, nil)
+		return
+	}
+	offset = min(offset, uint64(len(file)))
+	end := offset + min(length, uint64(len(file))-offset)
+	callback(core.ResultOk, bytes.Clone(file[offset:end]))
+}
+
+func (m *memStorage) Write(name string, data []byte) core.Result {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.clock++
+	m.files[name] = bytes.Clone(data)
+	m.modified[name] = m.clock
+	return core.ResultOk
+}
+
+func (m *memStorage) WriteAsync(name string, data []byte, callback func(result core.Result)) {
+	callback(m.Write(name, data))
+}
+
+func (m *memStorage) Delete(name string) core.Result {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.deleteResult != core.ResultOk {
+		return m.deleteResult
+	}
+	if _, ok := m.files[name]; !ok {
+		return core.ResultNotFound
+	}
+	delete(m.files, name)
+	delete(m.modified, name)
+	return core.ResultOk
+}
+
+func (m *memStorage) Exists(name string) (bool, core.Result) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.files[name]
+	return ok, core.ResultOk
+}
+
+func (m *memStorage) Count() (int32, core.Result) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return int32(len(m.files)), core.ResultOk
+}
+
+func (m *memStorage) Stat(name string) (*core.FileStat, core.Result) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	file, ok := m.files[name]
+	if !ok {
+		return nil, core.ResultNotFound
+	}
+	return &core.FileStat{Filename: name, Size: uint64(len(file)), LastModified: m.modified[name]}, core.ResultOk
+}
+
+func (m *memStorage) StatAt(index int32) (*core.FileStat, core.Result) {
+	m.mu.Lock()
+	names := slices.Sorted(maps.Keys(m.files))
+	m.mu.Unlock()
+	if index < 0 || int(index) >= len(names) {
+		return nil, core.ResultNotFound
+	}
+	return m.Stat(names[index])
+}
+
+func (m *memStorage) GetPath() (string, core.Result) {
+	return "memory", core.ResultOk
+}
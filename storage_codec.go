@@ -0,0 +1,246 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Codec marshals values for structured saves. Implement it to store saves with
+// protobuf, msgpack or any other encoding and register it with RegisterCodec.
+type Codec interface {
+	// Name identifies the codec in stored files and must be unique
+	Name() string
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// JSONCodec encodes values with encoding/json
+var JSONCodec Codec = jsonCodec{}
+
+// GobCodec encodes values with encoding/gob
+var GobCodec Codec = gobCodec{}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string                       { return "json" }
+func (jsonCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+type gobCodec struct{}
+
+func (gobCodec) Name() string { return "gob" }
+
+func (gobCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (gobCodec) Unmarshal(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = map[string]Codec{
+		JSONCodec.Name(): JSONCodec,
+		GobCodec.Name():  GobCodec,
+	}
+)
+
+// RegisterCodec makes a codec available for decoding saves that were stored with it
+func RegisterCodec(codec Codec) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+	codecs[codec.Name()] = codec
+}
+
+func lookupCodec(name string) (Codec, bool) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	codec, ok := codecs[name]
+	return codec, ok
+}
+
+// SaveJSON marshals v as JSON and writes it to name, respecting context cancellation and timeout
+func SaveJSON[T any](ctx context.Context, sc *StorageClient, name string, v T) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %q: %v", name, err)
+	}
+	return sc.WriteWithContext(ctx, name, data)
+}
+
+// LoadJSON reads name and unmarshals it from JSON
+func LoadJSON[T any](sc *StorageClient, name string) (T, error) {
+	var v T
+	data, err := sc.ReadAll(name)
+	if err != nil {
+		return v, err
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return v, fmt.Errorf("failed to unmarshal %q: %v", name, err)
+	}
+	return v, nil
+}
+
+// Migration upgrades a save stored with codec from one schema version to the next.
+// It receives and returns the encoded save.
+type Migration func(data []byte, codec Codec) ([]byte, error)
+
+// Versioned save layout:
+//
+//	magic    [4]byte "DGSV"
+//	version  uint32 big-endian schema version
+//	codecLen uint8
+//	codec    [codecLen]byte codec name
+//	payload  ...
+var schemaMagic = [4]byte{'D', 'G', 'S', 'V'}
+
+// SaveSchema stores values of type T with a schema version and upgrades older
+// saves through registered migrations when they are loaded.
+//
+// Files without a version header, such as saves written with SaveJSON, are
+// treated as version 0 encoded with the schema's codec.
+type SaveSchema[T any] struct {
+	version uint32
+	codec   Codec
+
+	mu         sync.RWMutex
+	migrations map[uint32]Migration
+}
+
+// NewSaveSchema creates a schema whose saves are written at version with codec.
+// A nil codec selects JSONCodec.
+//
+// Example usage:
+//
+//	schema := discord.NewSaveSchema[SaveV2](2, discord.JSONCodec)
+//	schema.RegisterMigration(1, func(data []byte, codec discord.Codec) ([]byte, error) {
+//	    var old SaveV1
+//	    if err := codec.Unmarshal(data, &old); err != nil {
+//	        return nil, err
+//	    }
+//	    return codec.Marshal(SaveV2{Level: old.Level, Gold: old.Coins})
+//	})
+//	save, err := schema.Load(client.Storage(), "slot1.sav")
+func NewSaveSchema[T any](version uint32, codec Codec) *SaveSchema[T] {
+	if codec == nil {
+		codec = JSONCodec
+	}
+	return &SaveSchema[T]{
+		version:    version,
+		codec:      codec,
+		migrations: make(map[uint32]Migration),
+	}
+}
+
+// Version returns the schema version new saves are written with
+func (s *SaveSchema[T]) Version() uint32 {
+	return s.version
+}
+
+// RegisterMigration registers the migration that upgrades saves from version from to from+1
+func (s *SaveSchema[T]) RegisterMigration(from uint32, migration Migration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.migrations[from] = migration
+}
+
+// Encode marshals v with the schema's codec and version header
+func (s *SaveSchema[T]) Encode(v T) ([]byte, error) {
+	payload, err := s.codec.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal with %s: %v", s.codec.Name(), err)
+	}
+	name := s.codec.Name()
+	if len(name) > 255 {
+		return nil, fmt.Errorf("codec name %q is too long", name)
+	}
+	out := make([]byte, 0, len(schemaMagic)+5+len(name)+len(payload))
+	out = append(out, schemaMagic[:]...)
+	out = binary.BigEndian.AppendUint32(out, s.version)
+	out = append(out, byte(len(name)))
+	out = append(out, name...)
+	return append(out, payload...), nil
+}
+
+// Decode unmarshals a save, migrating it to the schema version first when needed
+func (s *SaveSchema[T]) Decode(data []byte) (T, error) {
+	var v T
+	version, codec, payload, err := s.parse(data)
+	if err != nil {
+		return v, err
+	}
+	if version > s.version {
+		return v, fmt.Errorf("save version %d is newer than schema version %d", version, s.version)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for ; version < s.version; version++ {
+		migration, ok := s.migrations[version]
+		if !ok {
+			return v, fmt.Errorf("no migration registered from save version %d", version)
+		}
+		if payload, err = migration(payload, codec); err != nil {
+			return v, fmt.Errorf("failed to migrate save from version %d: %v", version, err)
+		}
+	}
+
+	if err := codec.Unmarshal(payload, &v); err != nil {
+		return v, fmt.Errorf("failed to unmarshal with %s: %v", codec.Name(), err)
+	}
+	return v, nil
+}
+
+// Save encodes v and writes it to name, respecting context cancellation and timeout
+func (s *SaveSchema[T]) Save(ctx context.Context, sc *StorageClient, name string, v T) error {
+	data, err := s.Encode(v)
+	if err != nil {
+		return err
+	}
+	return sc.WriteWithContext(ctx, name, data)
+}
+
+// Load reads name and decodes it, migrating older saves to the schema version
+func (s *SaveSchema[T]) Load(sc *StorageClient, name string) (T, error) {
+	data, err := sc.ReadAll(name)
+	if err != nil {
+		var v T
+		return v, err
+	}
+	return s.Decode(data)
+}
+
+// parse splits a stored save into its version, codec and payload
+func (s *SaveSchema[T]) parse(data []byte) (uint32, Codec, []byte, error) {
+	if len(data) < len(schemaMagic) || !bytes.Equal(data[:len(schemaMagic)], schemaMagic[:]) {
+		return 0, s.codec, data, nil
+	}
+	header := len(schemaMagic) + 5
+	if len(data) < header {
+		return 0, nil, nil, fmt.Errorf("truncated save header")
+	}
+	version := binary.BigEndian.Uint32(data[len(schemaMagic):])
+	nameLen := int(data[header-1])
+	if len(data) < header+nameLen {
+		return 0, nil, nil, fmt.Errorf("truncated save header")
+	}
+	name := string(data[header : header+nameLen])
+	codec, ok := s.codec, name == s.codec.Name()
+	if !ok {
+		codec, ok = lookupCodec(name)
+	}
+	if !ok {
+		return 0, nil, nil, fmt.Errorf("save uses unregistered codec %q", name)
+	}
+	return version, codec, data[header+nameLen:], nil
+}
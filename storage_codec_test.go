@@ -0,0 +1,61 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ExampleSaveJSON demonstrates how to save and load a struct as JSON.
+// This example is for documentation only and requires a real, initialized StorageClient.
+func ExampleSaveJSON() {
+	var storageClient *StorageClient // Assume this is properly initialized
+
+	type Settings struct {
+		Volume int
+		Locale string
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := SaveJSON(ctx, storageClient, "settings.json", Settings{Volume: 80, Locale: "en-US"}); err != nil {
+		fmt.Printf("failed to save settings: %v\n", err)
+		return
+	}
+	settings, err := LoadJSON[Settings](storageClient, "settings.json")
+	if err != nil {
+		fmt.Printf("failed to load settings: %v\n", err)
+		return
+	}
+	fmt.Printf("Volume: %d\n", settings.Volume)
+	// No Output: (documentation only)
+}
+
+// ExampleSaveSchema demonstrates how an old save is migrated to the current schema on load.
+func ExampleSaveSchema() {
+	type SaveV1 struct {
+		Level int
+		Coins int
+	}
+	type SaveV2 struct {
+		Level int
+		Gold  int
+	}
+
+	v1 := NewSaveSchema[SaveV1](1, GobCodec)
+	old, _ := v1.Encode(SaveV1{Level: 3, Coins: 120})
+
+	v2 := NewSaveSchema[SaveV2](2, JSONCodec)
+	v2.RegisterMigration(1, func(data []byte, codec Codec) ([]byte, error) {
+		var save SaveV1
+		if err := codec.Unmarshal(data, &save); err != nil {
+			return nil, err
+		}
+		return codec.Marshal(SaveV2{Level: save.Level, Gold: save.Coins})
+	})
+
+	save, err := v2.Decode(old)
+	fmt.Printf("%+v %v\n", save, err)
+	// Output: {Level:3 Gold:120} <nil>
+}
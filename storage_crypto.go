@@ -0,0 +1,125 @@
+package discord
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+)
+
+// Encrypted file layout:
+//
+//	magic   [4]byte "DGSC"
+//	version uint8
+//	nonce   [12]byte
+//	sealed  AES-256-GCM ciphertext and tag, authenticated with the file name
+const encryptedVersion = 1
+
+var encryptedMagic = [4]byte{'D', 'G', 'S', 'C'}
+
+// StorageKeySize is the size in bytes of keys returned by DeriveStorageKey
+const StorageKeySize = 32
+
+// DeriveStorageKey derives the AES-256 key used to encrypt storage for one user.
+// The key depends on both the caller's secret and the user ID, so a file copied
+// from another account's storage path fails authentication.
+func DeriveStorageKey(secret []byte, userID int64) ([]byte, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("storage secret must not be empty")
+	}
+	return hkdf.Key(sha256.New, secret, nil, "discordgamesdk-go storage user "+strconv.FormatInt(userID, 10), StorageKeySize)
+}
+
+// SealEncrypted encrypts data stored under name with AES-GCM. The name is
+// authenticated, so renaming an encrypted file makes it fail to open.
+func SealEncrypted(key []byte, name string, data []byte) ([]byte, error) {
+	aead, err := newStorageAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, len(encryptedMagic)+1+aead.NonceSize(), len(encryptedMagic)+1+aead.NonceSize()+len(data)+aead.Overhead())
+	copy(out, encryptedMagic[:])
+	out[len(encryptedMagic)] = encryptedVersion
+	nonce := out[len(encryptedMagic)+1:]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %v", err)
+	}
+	return aead.Seal(out, nonce, data, []byte(name)), nil
+}
+
+// OpenEncrypted decrypts and authenticates data read from name.
+// Authentication failures are reported as *StorageCorruptError.
+func OpenEncrypted(key []byte, name string, data []byte) ([]byte, error) {
+	aead, err := newStorageAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	headerSize := len(encryptedMagic) + 1 + aead.NonceSize()
+	if len(data) < headerSize || !bytes.Equal(data[:len(encryptedMagic)], encryptedMagic[:]) {
+		return nil, &StorageCorruptError{Name: name, Reason: "file is not encrypted"}
+	}
+	if data[len(encryptedMagic)] != encryptedVersion {
+		return nil, &StorageCorruptError{Name: name, Reason: fmt.Sprintf("unsupported encryption version %d", data[len(encryptedMagic)])}
+	}
+	nonce := data[len(encryptedMagic)+1 : headerSize]
+	plain, err := aead.Open(nil, nonce, data[headerSize:], []byte(name))
+	if err != nil {
+		return nil, &StorageCorruptError{Name: name, Reason: "authentication failed"}
+	}
+	return plain, nil
+}
+
+// WithEncryption returns a copy of the storage client that encrypts everything it
+// writes with a key derived from secret and the current user's ID, and rejects
+// files that are not encrypted for that user. Encryption is applied after any
+// envelope configured with WithEnvelope, so compression still takes effect.
+// ReadAt and ReaderAt return ErrSealedRangedRead.
+//
+// Example usage:
+//
+//	storage, err := client.Storage().WithEncryption(gameSecret)
+//	if err != nil {
+//	    log.Fatalf("failed to enable encryption: %v", err)
+//	}
+//	data, err := storage.ReadAll("unlocks.dat")
+//	var corrupt *discord.StorageCorruptError
+//	if errors.As(err, &corrupt) {
+//	    log.Printf("unlocks were tampered with: %s", corrupt.Reason)
+//	}
+//
+// Returns an error if the current user is not available yet.
+func (sc *StorageClient) WithEncryption(secret []byte) (*StorageClient, error) {
+	if sc.core == nil {
+		return nil, fmt.Errorf("core not available")
+	}
+	users := &UserClient{manager: sc.core.GetUserManager(), core: sc.core}
+	user, err := users.GetCurrentUser()
+	if err != nil {
+		return nil, err
+	}
+	return sc.WithEncryptionForUser(secret, user.ID)
+}
+
+// WithEncryptionForUser is like WithEncryption but uses the given user ID
+// instead of looking up the current user.
+func (sc *StorageClient) WithEncryptionForUser(secret []byte, userID int64) (*StorageClient, error) {
+	key, err := DeriveStorageKey(secret, userID)
+	if err != nil {
+		return nil, err
+	}
+	clone := *sc
+	clone.key = key
+	return &clone, nil
+}
+
+func newStorageAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid storage key: %v", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,33 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ExampleSealEncrypted demonstrates how per-user keys reject files copied from another account.
+func ExampleSealEncrypted() {
+	secret := []byte("game secret")
+	aliceKey, _ := DeriveStorageKey(secret, 1001)
+	bobKey, _ := DeriveStorageKey(secret, 1002)
+
+	sealed, err := SealEncrypted(aliceKey, "unlocks.dat", []byte("all_levels=true"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	data, err := OpenEncrypted(aliceKey, "unlocks.dat", sealed)
+	fmt.Println(string(data), err)
+
+	_, err = OpenEncrypted(bobKey, "unlocks.dat", sealed)
+	var corrupt *StorageCorruptError
+	fmt.Println(errors.As(err, &corrupt), corrupt.Reason)
+
+	_, err = OpenEncrypted(aliceKey, "other.dat", sealed)
+	fmt.Println(err)
+	// Output:
+	// all_levels=true <nil>
+	// true authentication failed
+	// corrupt storage file "other.dat": authentication failed
+}
@@ -0,0 +1,358 @@
+package discord
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"sync"
+)
+
+// EnvelopeCompression identifies the compression applied to an envelope payload
+type EnvelopeCompression uint8
+
+const (
+	// EnvelopeCompressionNone stores the payload uncompressed
+	EnvelopeCompressionNone EnvelopeCompression = 0
+	// EnvelopeCompressionGzip compresses the payload with gzip
+	EnvelopeCompressionGzip EnvelopeCompression = 1
+	// EnvelopeCompressionZstd compresses the payload with zstd.
+	// The standard library has no zstd implementation, so a Compressor must be
+	// registered with RegisterEnvelopeCompressor before it can be used.
+	EnvelopeCompressionZstd EnvelopeCompression = 2
+)
+
+// String returns a string representation of the EnvelopeCompression
+func (c EnvelopeCompression) String() string {
+	switch c {
+	case EnvelopeCompressionNone:
+		return "None"
+	case EnvelopeCompressionGzip:
+		return "Gzip"
+	case EnvelopeCompressionZstd:
+		return "Zstd"
+	default:
+		return fmt.Sprintf("EnvelopeCompression(%d)", uint8(c))
+	}
+}
+
+// EnvelopeChecksum identifies the checksum stored in an envelope
+type EnvelopeChecksum uint8
+
+const (
+	// EnvelopeChecksumCRC32 stores an IEEE CRC-32 of the payload
+	EnvelopeChecksumCRC32 EnvelopeChecksum = 0
+	// EnvelopeChecksumSHA256 stores a SHA-256 digest of the payload
+	EnvelopeChecksumSHA256 EnvelopeChecksum = 1
+)
+
+// String returns a string representation of the EnvelopeChecksum
+func (c EnvelopeChecksum) String() string {
+	switch c {
+	case EnvelopeChecksumCRC32:
+		return "CRC32"
+	case EnvelopeChecksumSHA256:
+		return "SHA256"
+	default:
+		return fmt.Sprintf("EnvelopeChecksum(%d)", uint8(c))
+	}
+}
+
+// EnvelopeOptions selects how StorageClient wraps files it writes
+type EnvelopeOptions struct {
+	Compression EnvelopeCompression
+	Checksum    EnvelopeChecksum
+	// AllowLegacy reads files without the envelope header back unchanged, for saves
+	// written before envelopes were enabled. Files that look like an envelope with a
+	// damaged header are still reported as corrupt.
+	AllowLegacy bool
+}
+
+// Compressor compresses and decompresses envelope payloads.
+//
+// Decompress receives the payload length recorded in the envelope header and must stop
+// after producing size+1 bytes, so a corrupt or hostile payload cannot expand without
+// limit. OpenEnvelope reports any length other than size as corrupt.
+type Compressor interface {
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte, size uint64) ([]byte, error)
+}
+
+// Envelope layout:
+//
+//	magic    [4]byte "DGSE"
+//	version  uint8
+//	compress uint8  EnvelopeCompression
+//	checksum uint8  EnvelopeChecksum
+//	reserved uint8
+//	length   uint64 big-endian length of the uncompressed payload
+//	sum      [4]byte or [32]byte checksum of the uncompressed payload
+//	payload  ...
+const (
+	envelopeVersion    = 1
+	envelopeHeaderSize = 16
+)
+
+var envelopeMagic = [4]byte{'D', 'G', 'S', 'E'}
+
+var (
+	compressorsMu sync.RWMutex
+	compressors   = map[EnvelopeCompression]Compressor{
+		EnvelopeCompressionGzip: gzipCompressor{},
+	}
+)
+
+// RegisterEnvelopeCompressor installs the Compressor used for a compression type,
+// replacing any previous one. Use it to plug in a zstd implementation.
+func RegisterEnvelopeCompressor(compression EnvelopeCompression, compressor Compressor) {
+	compressorsMu.Lock()
+	defer compressorsMu.Unlock()
+	if compressor == nil {
+		delete(compressors, compression)
+		return
+	}
+	compressors[compression] = compressor
+}
+
+// ErrNoCompressor is returned when an envelope uses a compression type that has no
+// registered Compressor. The stored file is not necessarily corrupt.
+var ErrNoCompressor = errors.New("no compressor registered")
+
+func lookupCompressor(compression EnvelopeCompression) (Compressor, bool) {
+	compressorsMu.RLock()
+	defer compressorsMu.RUnlock()
+	compressor, ok := compressors[compression]
+	return compressor, ok
+}
+
+// StorageCorruptError is returned when a stored file fails envelope validation or decryption
+type StorageCorruptError struct {
+	Name   string // storage file name, empty when decoding bytes directly
+	Reason string
+}
+
+// Error implements the error interface
+func (e *StorageCorruptError) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("corrupt storage file: %s", e.Reason)
+	}
+	return fmt.Sprintf("corrupt storage file %q: %s", e.Name, e.Reason)
+}
+
+// IsEnvelope reports whether data starts with the envelope magic header
+func IsEnvelope(data []byte) bool {
+	return len(data) >= len(envelopeMagic) && bytes.Equal(data[:len(envelopeMagic)], envelopeMagic[:])
+}
+
+// SealEnvelope wraps data in an envelope with the given compression and checksum
+func SealEnvelope(data []byte, opts EnvelopeOptions) ([]byte, error) {
+	sum, err := envelopeSum(opts.Checksum, data)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := data
+	if opts.Compression != EnvelopeCompressionNone {
+		compressor, ok := lookupCompressor(opts.Compression)
+		if !ok {
+			return nil, fmt.Errorf("%w for %v", ErrNoCompressor, opts.Compression)
+		}
+		if payload, err = compressor.Compress(data); err != nil {
+			return nil, fmt.Errorf("failed to compress: %v", err)
+		}
+	}
+
+	out := make([]byte, envelopeHeaderSize, envelopeHeaderSize+len(sum)+len(payload))
+	copy(out, envelopeMagic[:])
+	out[4] = envelopeVersion
+	out[5] = byte(opts.Compression)
+	out[6] = byte(opts.Checksum)
+	binary.BigEndian.PutUint64(out[8:16], uint64(len(data)))
+	out = append(out, sum...)
+	out = append(out, payload...)
+	return out, nil
+}
+
+// OpenEnvelope validates an envelope and returns the original data.
+// Validation failures are reported as *StorageCorruptError, and a compression type
+// without a registered Compressor as ErrNoCompressor.
+func OpenEnvelope(data []byte) ([]byte, error) {
+	if len(data) < envelopeHeaderSize || !IsEnvelope(data) {
+		return nil, &StorageCorruptError{Reason: "missing envelope header"}
+	}
+	if data[4] != envelopeVersion {
+		return nil, &StorageCorruptError{Reason: fmt.Sprintf("unsupported envelope version %d", data[4])}
+	}
+	compression := EnvelopeCompression(data[5])
+	checksum := EnvelopeChecksum(data[6])
+	length := binary.BigEndian.Uint64(data[8:16])
+
+	sumSize, err := envelopeSumSize(checksum)
+	if err != nil {
+		return nil, &StorageCorruptError{Reason: err.Error()}
+	}
+	if len(data) < envelopeHeaderSize+sumSize {
+		return nil, &StorageCorruptError{Reason: "truncated checksum"}
+	}
+	sum := data[envelopeHeaderSize : envelopeHeaderSize+sumSize]
+	payload := data[envelopeHeaderSize+sumSize:]
+
+	if compression != EnvelopeCompressionNone {
+		compressor, ok := lookupCompressor(compression)
+		if !ok {
+			return nil, fmt.Errorf("%w for %v", ErrNoCompressor, compression)
+		}
+		if payload, err = compressor.Decompress(payload, length); err != nil {
+			return nil, &StorageCorruptError{Reason: fmt.Sprintf("failed to decompress: %v", err)}
+		}
+	}
+	if uint64(len(payload)) != length {
+		return nil, &StorageCorruptError{Reason: fmt.Sprintf("length mismatch: header %d, payload %d", length, len(payload))}
+	}
+	actual, _ := envelopeSum(checksum, payload)
+	if !bytes.Equal(actual, sum) {
+		return nil, &StorageCorruptError{Reason: fmt.Sprintf("%v checksum mismatch", checksum)}
+	}
+	return payload, nil
+}
+
+// WithEnvelope returns a copy of the storage client that seals everything it writes
+// in an envelope and validates envelopes on read. Files without the envelope header
+// are reported as *StorageCorruptError unless opts.AllowLegacy is set.
+// ReadAt and ReaderAt return ErrSealedRangedRead.
+//
+// Example usage:
+//
+//	storage := client.Storage().WithEnvelope(discord.EnvelopeOptions{
+//	    Compression: discord.EnvelopeCompressionGzip,
+//	    Checksum:    discord.EnvelopeChecksumSHA256,
+//	})
+//	data, err := storage.ReadAll("slot1.sav")
+//	var corrupt *discord.StorageCorruptError
+//	if errors.As(err, &corrupt) {
+//	    log.Printf("save is corrupt: %s", corrupt.Reason)
+//	}
+func (sc *StorageClient) WithEnvelope(opts EnvelopeOptions) *StorageClient {
+	clone := *sc
+	clone.envelope = &opts
+	return &clone
+}
+
+// sealing reports whether files are stored in an envelope or encrypted, so the
+// stored size of a file differs from the size of its contents
+func (sc *StorageClient) sealing() bool {
+	return sc.envelope != nil || sc.key != nil
+}
+
+// seal wraps data written to name in the configured envelope and encryption
+func (sc *StorageClient) seal(name string, data []byte) ([]byte, error) {
+	var err error
+	if sc.envelope != nil {
+		if data, err = SealEnvelope(data, *sc.envelope); err != nil {
+			return nil, err
+		}
+	}
+	if sc.key != nil {
+		return SealEncrypted(sc.key, name, data)
+	}
+	return data, nil
+}
+
+// unseal reverses seal for data read from name
+func (sc *StorageClient) unseal(name string, data []byte) ([]byte, error) {
+	var err error
+	if sc.key != nil {
+		if data, err = OpenEncrypted(sc.key, name, data); err != nil {
+			return nil, err
+		}
+	}
+	if sc.envelope == nil {
+		return data, nil
+	}
+	if !IsEnvelope(data) {
+		if sc.envelope.AllowLegacy && !damagedEnvelope(data) {
+			return data, nil
+		}
+		return nil, &StorageCorruptError{Name: name, Reason: "missing envelope header"}
+	}
+	plain, err := OpenEnvelope(data)
+	if corrupt, ok := err.(*StorageCorruptError); ok {
+		corrupt.Name = name
+	}
+	return plain, err
+}
+
+// damagedEnvelope reports whether data without the magic header still looks like an
+// envelope: the rest of the header is valid and most of the magic survived
+func damagedEnvelope(data []byte) bool {
+	if len(data) < envelopeHeaderSize || data[4] != envelopeVersion || data[7] != 0 {
+		return false
+	}
+	if _, err := envelopeSumSize(EnvelopeChecksum(data[6])); err != nil {
+		return false
+	}
+	if EnvelopeCompression(data[5]) > EnvelopeCompressionZstd {
+		return false
+	}
+	matching := 0
+	for i, b := range envelopeMagic {
+		if data[i] == b {
+			matching++
+		}
+	}
+	return matching >= len(envelopeMagic)/2
+}
+
+func envelopeSumSize(checksum EnvelopeChecksum) (int, error) {
+	switch checksum {
+	case EnvelopeChecksumCRC32:
+		return crc32.Size, nil
+	case EnvelopeChecksumSHA256:
+		return sha256.Size, nil
+	default:
+		return 0, fmt.Errorf("unknown checksum %v", checksum)
+	}
+}
+
+func envelopeSum(checksum EnvelopeChecksum, data []byte) ([]byte, error) {
+	switch checksum {
+	case EnvelopeChecksumCRC32:
+		return binary.BigEndian.AppendUint32(nil, crc32.ChecksumIEEE(data)), nil
+	case EnvelopeChecksumSHA256:
+		sum := sha256.Sum256(data)
+		return sum[:], nil
+	default:
+		return nil, fmt.Errorf("unknown checksum %v", checksum)
+	}
+}
+
+type gzipCompressor struct{}
+
+func (gzipCompressor) Compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (gzipCompressor) Decompress(data []byte, size uint64) ([]byte, error) {
+	if size >= math.MaxInt64 {
+		return nil, fmt.Errorf("payload length %d too large", size)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return io.ReadAll(io.LimitReader(zr, int64(size)+1))
+}
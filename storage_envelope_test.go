@@ -0,0 +1,95 @@
+package discord
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ExampleSealEnvelope demonstrates how envelopes round-trip data and detect corruption.
+func ExampleSealEnvelope() {
+	opts := EnvelopeOptions{Compression: EnvelopeCompressionGzip, Checksum: EnvelopeChecksumSHA256}
+	sealed, err := SealEnvelope([]byte("level=4;gold=120"), opts)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	data, err := OpenEnvelope(sealed)
+	fmt.Println(string(data), err)
+
+	sealed[len(sealed)-5] ^= 0xff
+	_, err = OpenEnvelope(sealed)
+	var corrupt *StorageCorruptError
+	fmt.Println(errors.As(err, &corrupt))
+	// Output:
+	// level=4;gold=120 <nil>
+	// true
+}
+
+// ExampleStorageClient_WithEnvelope demonstrates how to enable envelopes for a storage client.
+// This example is for documentation only and requires a real, initialized StorageClient.
+func ExampleStorageClient_WithEnvelope() {
+	var storageClient *StorageClient // Assume this is properly initialized
+
+	storage := storageClient.WithEnvelope(EnvelopeOptions{
+		Compression: EnvelopeCompressionGzip,
+		Checksum:    EnvelopeChecksumCRC32,
+	})
+	if err := storage.Write("slot1.sav", []byte("level=4")); err != nil {
+		fmt.Printf("failed to write: %v\n", err)
+		return
+	}
+	data, err := storage.ReadAll("slot1.sav")
+	var corrupt *StorageCorruptError
+	if errors.As(err, &corrupt) {
+		fmt.Printf("save is corrupt: %s\n", corrupt.Reason)
+		return
+	}
+	fmt.Printf("Read %d bytes\n", len(data))
+	// No Output: (documentation only)
+}
+
+type identityCompressor struct{}
+
+func (identityCompressor) Compress(data []byte) ([]byte, error)                { return data, nil }
+func (identityCompressor) Decompress(data []byte, size uint64) ([]byte, error) { return data, nil }
+
+// ExampleEnvelopeOptions_allowLegacy demonstrates how files without a valid envelope header are read.
+func ExampleEnvelopeOptions_allowLegacy() {
+	var corrupt *StorageCorruptError
+	strict := (&StorageClient{}).WithEnvelope(EnvelopeOptions{})
+	legacy := (&StorageClient{}).WithEnvelope(EnvelopeOptions{AllowLegacy: true})
+
+	data, err := legacy.unseal("old.sav", []byte("level=4"))
+	fmt.Println(string(data), err)
+	_, err = strict.unseal("old.sav", []byte("level=4"))
+	fmt.Println(errors.As(err, &corrupt))
+
+	sealed, _ := SealEnvelope([]byte("level=4"), EnvelopeOptions{})
+	sealed[0] ^= 0xff
+	_, err = legacy.unseal("slot1.sav", sealed)
+	fmt.Println(errors.As(err, &corrupt), corrupt.Reason)
+
+	RegisterEnvelopeCompressor(EnvelopeCompressionZstd, identityCompressor{})
+	sealed, _ = SealEnvelope([]byte("level=4"), EnvelopeOptions{Compression: EnvelopeCompressionZstd})
+	RegisterEnvelopeCompressor(EnvelopeCompressionZstd, nil)
+	_, err = strict.unseal("slot1.sav", sealed)
+	fmt.Println(errors.Is(err, ErrNoCompressor), errors.As(err, &corrupt))
+	// Output:
+	// level=4 <nil>
+	// true
+	// true missing envelope header
+	// true false
+}
+
+// ExampleOpenEnvelope_lengthLimit shows that decompression stops one byte past the length
+// recorded in the header, so a payload that expands further is reported as corrupt.
+func ExampleOpenEnvelope_lengthLimit() {
+	sealed, _ := SealEnvelope(make([]byte, 1<<20), EnvelopeOptions{Compression: EnvelopeCompressionGzip})
+	binary.BigEndian.PutUint64(sealed[8:16], 16)
+	_, err := OpenEnvelope(sealed)
+	fmt.Println(err)
+	// Output:
+	// corrupt storage file: length mismatch: header 16, payload 17
+}
@@ -0,0 +1,384 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// StorageFS exposes Discord cloud storage as a read-only io/fs file system.
+//
+// Storage is flat, but file names containing slashes are presented as files
+// inside virtual directories, so "saves/slot1.sav" is listed under "saves".
+//
+// File sizes are those of the contents Open returns. When the client stores files
+// in an envelope or encrypted, Stat and ReadDir read each file to learn that size.
+type StorageFS struct {
+	sc *StorageClient
+}
+
+var (
+	_ fs.FS         = (*StorageFS)(nil)
+	_ fs.StatFS     = (*StorageFS)(nil)
+	_ fs.ReadDirFS  = (*StorageFS)(nil)
+	_ fs.ReadFileFS = (*StorageFS)(nil)
+
+	_ fs.FS        = (*StorageClient)(nil)
+	_ fs.ReadDirFS = (*StorageClient)(nil)
+)
+
+// FS returns an io/fs view of storage that also implements fs.StatFS and fs.ReadFileFS.
+//
+// Example usage:
+//
+//	fsys := client.Storage().FS()
+//	matches, err := fs.Glob(fsys, "saves/*.sav")
+//	if err != nil {
+//	    log.Fatalf("failed to list saves: %v", err)
+//	}
+func (sc *StorageClient) FS() *StorageFS {
+	return &StorageFS{sc: sc}
+}
+
+// Open opens the named file for reading, implementing fs.FS
+func (sc *StorageClient) Open(name string) (fs.File, error) {
+	return sc.FS().Open(name)
+}
+
+// ReadDir lists the named directory, implementing fs.ReadDirFS
+func (sc *StorageClient) ReadDir(name string) ([]fs.DirEntry, error) {
+	return sc.FS().ReadDir(name)
+}
+
+// StatAt gets file statistics at index
+func (sc *StorageClient) StatAt(index int32) (*core.FileStat, error) {
+	if sc.manager == nil {
+		return nil, fmt.Errorf("storage manager not available")
+	}
+	stat, result := sc.manager.StatAt(index)
+	if result != core.ResultOk {
+		return nil, fmt.Errorf("failed to stat at index: %v", result)
+	}
+	return stat, nil
+}
+
+// List returns the statistics of every file in storage
+func (sc *StorageClient) List() ([]core.FileStat, error) {
+	count, err := sc.Count()
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]core.FileStat, 0, count)
+	for i := int32(0); i < count; i++ {
+		stat, err := sc.StatAt(i)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, *stat)
+	}
+	return stats, nil
+}
+
+// ReadAll reads the whole named file, sizing the buffer from its file statistics
+func (sc *StorageClient) ReadAll(name string) ([]byte, error) {
+	if sc.manager == nil {
+		return nil, fmt.Errorf("storage manager not available")
+	}
+	stat, result := sc.manager.Stat(name)
+	if result != core.ResultOk {
+		return nil, fmt.Errorf("failed to stat: %v", result)
+	}
+	buf := make([]byte, stat.Size)
+	n, result := sc.manager.Read(name, buf)
+	if result != core.ResultOk {
+		return nil, fmt.Errorf("failed to read: %v", result)
+	}
+	return sc.unseal(name, buf[:n])
+}
+
+// NewReader returns an io.ReadCloser over the contents of the named file
+func (sc *StorageClient) NewReader(name string) (io.ReadCloser, error) {
+	data, err := sc.ReadAll(name)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// NewWriter returns an io.WriteCloser that buffers everything written to it and
+// stores it under name when closed. Close respects ctx cancellation and timeout.
+//
+// Example usage:
+//
+//	w := client.Storage().NewWriter(ctx, "saves/slot1.sav")
+//	if err := json.NewEncoder(w).Encode(save); err != nil {
+//	    log.Fatalf("failed to encode save: %v", err)
+//	}
+//	if err := w.Close(); err != nil {
+//	    log.Fatalf("failed to write save: %v", err)
+//	}
+func (sc *StorageClient) NewWriter(ctx context.Context, name string) io.WriteCloser {
+	return &storageWriter{sc: sc, ctx: ctx, name: name}
+}
+
+type storageWriter struct {
+	sc     *StorageClient
+	ctx    context.Context
+	name   string
+	buf    bytes.Buffer
+	closed bool
+}
+
+func (w *storageWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, fs.ErrClosed
+	}
+	return w.buf.Write(p)
+}
+
+func (w *storageWriter) Close() error {
+	if w.closed {
+		return fs.ErrClosed
+	}
+	w.closed = true
+	return w.sc.WriteWithContext(w.ctx, w.name, w.buf.Bytes())
+}
+
+// Open opens the named file or virtual directory for reading
+func (fsys *StorageFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if stat, ok, err := fsys.statFile(name); err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	} else if ok {
+		data, err := fsys.sc.ReadAll(name)
+		if err != nil {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+		}
+		info := newStorageFileInfo(stat)
+		info.size = int64(len(data))
+		return &storageFile{info: info, Reader: bytes.NewReader(data)}, nil
+	}
+	entries, ok, err := fsys.readDir(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return &storageDir{info: storageDirInfo(name), entries: entries}, nil
+}
+
+// Stat returns a fs.FileInfo describing the named file or virtual directory
+func (fsys *StorageFS) Stat(name string) (fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrInvalid}
+	}
+	stat, ok, err := fsys.statFile(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+	}
+	if ok {
+		info, err := fsys.fileInfo(stat)
+		if err != nil {
+			return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+		}
+		return info, nil
+	}
+	if _, ok, err := fsys.readDir(name); err != nil {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+	} else if ok {
+		return storageDirInfo(name), nil
+	}
+	return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+}
+
+// ReadDir lists the named virtual directory, sorted by name. Use "." for the root.
+func (fsys *StorageFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+	entries, ok, err := fsys.readDir(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: err}
+	}
+	if !ok {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	return entries, nil
+}
+
+// ReadFile reads the whole named file
+func (fsys *StorageFS) ReadFile(name string) ([]byte, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readfile", Path: name, Err: fs.ErrInvalid}
+	}
+	if _, ok, err := fsys.statFile(name); err != nil {
+		return nil, &fs.PathError{Op: "readfile", Path: name, Err: err}
+	} else if !ok {
+		return nil, &fs.PathError{Op: "readfile", Path: name, Err: fs.ErrNotExist}
+	}
+	data, err := fsys.sc.ReadAll(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "readfile", Path: name, Err: err}
+	}
+	return data, nil
+}
+
+// statFile returns the statistics of a stored file, reporting false if no file has that name
+func (fsys *StorageFS) statFile(name string) (*core.FileStat, bool, error) {
+	if name == "." {
+		return nil, false, nil
+	}
+	exists, err := fsys.sc.Exists(name)
+	if err != nil || !exists {
+		return nil, false, err
+	}
+	stat, err := fsys.sc.Stat(name)
+	if err != nil {
+		return nil, false, err
+	}
+	return stat, true, nil
+}
+
+// fileInfo describes a stored file, reading it to size the contents if the client seals files
+func (fsys *StorageFS) fileInfo(stat *core.FileStat) (*storageFileInfo, error) {
+	info := newStorageFileInfo(stat)
+	if fsys.sc.sealing() {
+		data, err := fsys.sc.ReadAll(stat.Filename)
+		if err != nil {
+			return nil, err
+		}
+		info.size = int64(len(data))
+	}
+	return info, nil
+}
+
+// readDir builds the entries of a virtual directory from the flat file list,
+// reporting false if no stored file lives under it
+func (fsys *StorageFS) readDir(name string) ([]fs.DirEntry, bool, error) {
+	stats, err := fsys.sc.List()
+	if err != nil {
+		return nil, false, err
+	}
+	prefix := ""
+	if name != "." {
+		prefix = name + "/"
+	}
+
+	found := name == "."
+	files := make(map[string]fs.DirEntry)
+	dirs := make(map[string]fs.DirEntry)
+	for i := range stats {
+		rest, ok := strings.CutPrefix(stats[i].Filename, prefix)
+		if !ok || rest == "" {
+			continue
+		}
+		found = true
+		if child, _, isDir := strings.Cut(rest, "/"); isDir {
+			dirs[child] = fs.FileInfoToDirEntry(storageDirInfo(prefix + child))
+		} else {
+			info, err := fsys.fileInfo(&stats[i])
+			if err != nil {
+				return nil, false, err
+			}
+			files[child] = fs.FileInfoToDirEntry(info)
+		}
+	}
+	if !found {
+		return nil, false, nil
+	}
+
+	entries := make([]fs.DirEntry, 0, len(files)+len(dirs))
+	for _, entry := range files {
+		entries = append(entries, entry)
+	}
+	for child, entry := range dirs {
+		if _, clash := files[child]; !clash {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	return entries, true, nil
+}
+
+// storageFileInfo implements fs.FileInfo for stored files and virtual directories
+type storageFileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	dir     bool
+}
+
+func newStorageFileInfo(stat *core.FileStat) *storageFileInfo {
+	return &storageFileInfo{
+		name:    path.Base(stat.Filename),
+		size:    int64(stat.Size),
+		modTime: time.Unix(int64(stat.LastModified), 0),
+	}
+}
+
+func storageDirInfo(name string) *storageFileInfo {
+	return &storageFileInfo{name: path.Base(name), dir: true}
+}
+
+func (fi *storageFileInfo) Name() string       { return fi.name }
+func (fi *storageFileInfo) Size() int64        { return fi.size }
+func (fi *storageFileInfo) ModTime() time.Time { return fi.modTime }
+func (fi *storageFileInfo) IsDir() bool        { return fi.dir }
+func (fi *storageFileInfo) Sys() any           { return nil }
+
+func (fi *storageFileInfo) Mode() fs.FileMode {
+	if fi.dir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
+
+// storageFile is an opened stored file. The contents are read when the file is opened.
+type storageFile struct {
+	*bytes.Reader
+	info *storageFileInfo
+}
+
+func (f *storageFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *storageFile) Close() error               { return nil }
+
+// storageDir is an opened virtual directory
+type storageDir struct {
+	info    *storageFileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *storageDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *storageDir) Close() error               { return nil }
+
+func (d *storageDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+func (d *storageDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	remaining := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return remaining, nil
+	}
+	if len(remaining) == 0 {
+		return nil, io.EOF
+	}
+	if n > len(remaining) {
+		n = len(remaining)
+	}
+	d.offset += n
+	return remaining[:n], nil
+}
@@ -0,0 +1,386 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// mirrorStateFile holds the sync state inside the mirror directory
+const mirrorStateFile = ".discord-storage-mirror.json"
+
+// ConflictResolution tells a StorageMirror which copy of a conflicting file to keep
+type ConflictResolution int
+
+const (
+	// KeepLocal uploads the local copy, overwriting the remote one
+	KeepLocal ConflictResolution = iota
+	// KeepRemote downloads the remote copy, overwriting the local one
+	KeepRemote
+)
+
+// String returns a string representation of the ConflictResolution
+func (r ConflictResolution) String() string {
+	switch r {
+	case KeepLocal:
+		return "KeepLocal"
+	case KeepRemote:
+		return "KeepRemote"
+	default:
+		return fmt.Sprintf("ConflictResolution(%d)", int(r))
+	}
+}
+
+// StorageConflict describes a file that changed both locally and in Discord storage since the last sync
+type StorageConflict struct {
+	Name          string
+	LocalData     []byte
+	LocalModified time.Time
+	Remote        core.FileStat
+	RemoteData    []byte
+}
+
+// RemoteModified returns the remote modification time from Remote.LastModified
+func (c StorageConflict) RemoteModified() time.Time {
+	return time.Unix(int64(c.Remote.LastModified), 0)
+}
+
+// ConflictResolver decides how to resolve a conflict
+type ConflictResolver func(conflict StorageConflict) ConflictResolution
+
+// PreferLocal always keeps the local copy
+func PreferLocal(StorageConflict) ConflictResolution { return KeepLocal }
+
+// PreferRemote always keeps the remote copy
+func PreferRemote(StorageConflict) ConflictResolution { return KeepRemote }
+
+// PreferNewest keeps the most recently modified copy, preferring the local one on ties
+func PreferNewest(c StorageConflict) ConflictResolution {
+	if c.RemoteModified().After(c.LocalModified.Truncate(time.Second)) {
+		return KeepRemote
+	}
+	return KeepLocal
+}
+
+// mirrorRecord is the persisted sync state of one file
+type mirrorRecord struct {
+	RemoteModified uint64 `json:"remote_modified"` // remote LastModified at the last sync
+	Pending        bool   `json:"pending"`         // local changes not yet uploaded
+}
+
+// StorageMirror keeps a local directory in sync with Discord cloud storage.
+//
+// Writes go to disk immediately and are uploaded when a StorageClient is attached
+// and reachable, so saving keeps working while Discord is not running. Remote
+// deletions are not propagated.
+type StorageMirror struct {
+	dir     string
+	resolve ConflictResolver
+
+	mu      sync.Mutex
+	storage *StorageClient
+	records map[string]*mirrorRecord
+}
+
+// NewStorageMirror opens a mirror rooted at dir, creating it if needed.
+// A nil resolver selects PreferNewest.
+//
+// Example usage:
+//
+//	mirror, err := discord.NewStorageMirror(filepath.Join(configDir, "saves"), discord.PreferNewest)
+//	if err != nil {
+//	    log.Fatalf("failed to open mirror: %v", err)
+//	}
+//	// Later, once Discord is running:
+//	mirror.Attach(client.Storage())
+//	if err := mirror.Sync(ctx); err != nil {
+//	    log.Printf("sync failed, will retry: %v", err)
+//	}
+func NewStorageMirror(dir string, resolve ConflictResolver) (*StorageMirror, error) {
+	if resolve == nil {
+		resolve = PreferNewest
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create mirror directory: %v", err)
+	}
+	m := &StorageMirror{
+		dir:     dir,
+		resolve: resolve,
+		records: make(map[string]*mirrorRecord),
+	}
+	data, err := os.ReadFile(filepath.Join(dir, mirrorStateFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read mirror state: %v", err)
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &m.records); err != nil {
+			return nil, fmt.Errorf("failed to parse mirror state: %v", err)
+		}
+	}
+	return m, nil
+}
+
+// Attach sets the storage client used for syncing. Pass nil when Discord goes away.
+func (m *StorageMirror) Attach(storage *StorageClient) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.storage = storage
+}
+
+// Write stores data locally and uploads it right away when storage is attached.
+// A failed upload is not an error: the file stays pending until the next Sync.
+func (m *StorageMirror) Write(ctx context.Context, name string, data []byte) error {
+	path, err := m.localPath(name)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := writeFileAtomic(path, data); err != nil {
+		return err
+	}
+	m.record(name).Pending = true
+	if err := m.saveState(); err != nil {
+		return err
+	}
+	if m.storage != nil {
+		_ = m.syncFile(ctx, name)
+	}
+	return nil
+}
+
+// Read reads the local copy of name
+func (m *StorageMirror) Read(name string) ([]byte, error) {
+	path, err := m.localPath(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
+
+// Pending returns the names of files with local changes not yet uploaded, sorted by name
+func (m *StorageMirror) Pending() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var names []string
+	for name, rec := range m.records {
+		if rec.Pending {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Sync uploads pending local changes and downloads remote changes, resolving
+// files changed on both sides with the mirror's ConflictResolver.
+//
+// Returns an error if no storage is attached or storage cannot be reached;
+// pending files are kept and retried by the next Sync.
+func (m *StorageMirror) Sync(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.storage == nil {
+		return fmt.Errorf("storage mirror is not attached")
+	}
+
+	stats, err := m.storage.List()
+	if err != nil {
+		return err
+	}
+	names := make(map[string]bool)
+	for _, stat := range stats {
+		names[stat.Filename] = true
+	}
+	for name, rec := range m.records {
+		if rec.Pending {
+			names[name] = true
+		}
+	}
+
+	var errs []error
+	for name := range names {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if _, err := m.localPath(name); err != nil {
+			continue // remote names that cannot be mirrored on disk are left alone
+		}
+		if err := m.syncFile(ctx, name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RunSync calls Sync every interval until ctx is done, so pending writes reach
+// Discord storage once it becomes available. Sync errors are passed to onError if set.
+func (m *StorageMirror) RunSync(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := m.Sync(ctx); err != nil && onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// syncFile reconciles one file. Callers must hold m.mu and have storage attached.
+func (m *StorageMirror) syncFile(ctx context.Context, name string) error {
+	path, err := m.localPath(name)
+	if err != nil {
+		return err
+	}
+	rec := m.record(name)
+
+	exists, err := m.storage.Exists(name)
+	if err != nil {
+		return err
+	}
+	var remote *core.FileStat
+	if exists {
+		if remote, err = m.storage.Stat(name); err != nil {
+			return err
+		}
+	}
+	remoteChanged := remote != nil && remote.LastModified != rec.RemoteModified
+
+	switch {
+	case rec.Pending && remoteChanged:
+		local, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		remoteData, err := m.storage.ReadAll(name)
+		if err != nil {
+			return err
+		}
+		conflict := StorageConflict{
+			Name:          name,
+			LocalData:     local,
+			LocalModified: info.ModTime(),
+			Remote:        *remote,
+			RemoteData:    remoteData,
+		}
+		if m.resolve(conflict) == KeepRemote {
+			err = m.download(name, path, remoteData, remote)
+		} else {
+			err = m.upload(ctx, name, local)
+		}
+		if err != nil {
+			return err
+		}
+	case rec.Pending:
+		local, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := m.upload(ctx, name, local); err != nil {
+			return err
+		}
+	case remoteChanged:
+		data, err := m.storage.ReadAll(name)
+		if err != nil {
+			return err
+		}
+		if err := m.download(name, path, data, remote); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+	return m.saveState()
+}
+
+func (m *StorageMirror) upload(ctx context.Context, name string, data []byte) error {
+	if err := m.storage.WriteWithContext(ctx, name, data); err != nil {
+		return err
+	}
+	stat, err := m.storage.Stat(name)
+	if err != nil {
+		return err
+	}
+	rec := m.record(name)
+	rec.Pending = false
+	rec.RemoteModified = stat.LastModified
+	return nil
+}
+
+func (m *StorageMirror) download(name, path string, data []byte, remote *core.FileStat) error {
+	if err := writeFileAtomic(path, data); err != nil {
+		return err
+	}
+	rec := m.record(name)
+	rec.Pending = false
+	rec.RemoteModified = remote.LastModified
+	return nil
+}
+
+func (m *StorageMirror) record(name string) *mirrorRecord {
+	rec, ok := m.records[name]
+	if !ok {
+		rec = &mirrorRecord{}
+		m.records[name] = rec
+	}
+	return rec
+}
+
+func (m *StorageMirror) saveState() error {
+	data, err := json.Marshal(m.records)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(m.dir, mirrorStateFile), data)
+}
+
+// localPath maps a storage name to a path inside the mirror directory
+func (m *StorageMirror) localPath(name string) (string, error) {
+	if !fs.ValidPath(name) || name == "." || name == mirrorStateFile {
+		return "", fmt.Errorf("invalid storage file name %q", name)
+	}
+	return filepath.Join(m.dir, filepath.FromSlash(name)), nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it into place
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,42 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// ExampleStorageMirror demonstrates how saves keep working while Discord is not running.
+func ExampleStorageMirror() {
+	dir, err := os.MkdirTemp("", "mirror")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	mirror, err := NewStorageMirror(dir, PreferNewest)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	// No StorageClient is attached yet, so the write only reaches the disk
+	if err := mirror.Write(context.Background(), "saves/slot1.sav", []byte("level=4")); err != nil {
+		fmt.Println(err)
+		return
+	}
+	data, _ := mirror.Read("saves/slot1.sav")
+	fmt.Println(string(data))
+	fmt.Println(mirror.Pending())
+	fmt.Println(mirror.Sync(context.Background()))
+
+	// The pending write survives a restart
+	reopened, _ := NewStorageMirror(dir, PreferNewest)
+	fmt.Println(reopened.Pending())
+	// Output:
+	// level=4
+	// [saves/slot1.sav]
+	// storage mirror is not attached
+	// [saves/slot1.sav]
+}
@@ -0,0 +1,119 @@
+package discord
+
+import (
+	"fmt"
+	"sort"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// usageReportSize is the number of files listed in StorageUsage.Largest and Oldest
+const usageReportSize = 5
+
+// StorageUsage summarizes how much cloud storage is in use
+type StorageUsage struct {
+	Files      int
+	TotalBytes uint64
+	Quota      uint64          // 0 when no quota is configured
+	Largest    []core.FileStat // largest files first
+	Oldest     []core.FileStat // least recently modified files first
+}
+
+// Remaining returns the number of bytes that can still be written under the quota,
+// or 0 when no quota is configured
+func (u *StorageUsage) Remaining() uint64 {
+	if u.Quota == 0 || u.TotalBytes >= u.Quota {
+		return 0
+	}
+	return u.Quota - u.TotalBytes
+}
+
+// StorageQuotaError is returned when a write would exceed the configured quota
+type StorageQuotaError struct {
+	Name  string
+	Size  uint64 // stored size of the rejected write
+	Used  uint64 // bytes used by other files
+	Quota uint64
+}
+
+// Error implements the error interface
+func (e *StorageQuotaError) Error() string {
+	return fmt.Sprintf("writing %q (%d bytes) exceeds storage quota: %d of %d bytes used", e.Name, e.Size, e.Used, e.Quota)
+}
+
+// WithQuota returns a copy of the storage client that rejects writes that would
+// bring total storage usage above quota bytes. Sizes are measured after any
+// envelope and encryption are applied. A quota of 0 disables the check.
+//
+// Example usage:
+//
+//	storage := client.Storage().WithQuota(10 << 20)
+//	err := storage.Write("slot1.sav", data)
+//	var quotaErr *discord.StorageQuotaError
+//	if errors.As(err, &quotaErr) {
+//	    showMessage("Cloud saves are full")
+//	}
+func (sc *StorageClient) WithQuota(quota uint64) *StorageClient {
+	clone := *sc
+	clone.quota = quota
+	return &clone
+}
+
+// Usage walks every file in storage and reports the total size along with the
+// largest and oldest files.
+//
+// Example usage:
+//
+//	usage, err := client.Storage().Usage()
+//	if err != nil {
+//	    log.Fatalf("failed to get usage: %v", err)
+//	}
+//	fmt.Printf("%d files, %d bytes\n", usage.Files, usage.TotalBytes)
+//	for _, file := range usage.Largest {
+//	    fmt.Printf("%s: %d bytes\n", file.Filename, file.Size)
+//	}
+func (sc *StorageClient) Usage() (*StorageUsage, error) {
+	stats, err := sc.List()
+	if err != nil {
+		return nil, err
+	}
+	return newStorageUsage(stats, sc.quota), nil
+}
+
+func newStorageUsage(stats []core.FileStat, quota uint64) *StorageUsage {
+	usage := &StorageUsage{Files: len(stats), Quota: quota}
+	for _, stat := range stats {
+		usage.TotalBytes += stat.Size
+	}
+
+	largest := append([]core.FileStat(nil), stats...)
+	sort.SliceStable(largest, func(i, j int) bool { return largest[i].Size > largest[j].Size })
+	usage.Largest = largest[:min(len(largest), usageReportSize)]
+
+	oldest := append([]core.FileStat(nil), stats...)
+	sort.SliceStable(oldest, func(i, j int) bool { return oldest[i].LastModified < oldest[j].LastModified })
+	usage.Oldest = oldest[:min(len(oldest), usageReportSize)]
+	return usage
+}
+
+// checkQuota rejects writing size bytes to name when it would exceed the quota.
+// The current size of name is not counted since the write replaces it.
+func (sc *StorageClient) checkQuota(name string, size int) error {
+	if sc.quota == 0 {
+		return nil
+	}
+	stats, err := sc.List()
+	if err != nil {
+		return err
+	}
+	var used uint64
+	for _, stat := range stats {
+		if stat.Filename != name {
+			used += stat.Size
+		}
+	}
+	if used+uint64(size) > sc.quota {
+		return &StorageQuotaError{Name: name, Size: uint64(size), Used: used, Quota: sc.quota}
+	}
+	return nil
+}
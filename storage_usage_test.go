@@ -0,0 +1,87 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleStorageClient_Usage demonstrates how to show cloud save usage and enforce a quota.
+// This example is for documentation only and requires a real, initialized StorageClient.
+func ExampleStorageClient_Usage() {
+	var storageClient *StorageClient // Assume this is properly initialized
+
+	storage := storageClient.WithQuota(10 << 20)
+	usage, err := storage.Usage()
+	if err != nil {
+		fmt.Printf("failed to get usage: %v\n", err)
+		return
+	}
+	fmt.Printf("%d files, %d bytes used, %d bytes remaining\n", usage.Files, usage.TotalBytes, usage.Remaining())
+	for _, file := range usage.Oldest {
+		fmt.Printf("%s last modified at %d\n", file.Filename, file.LastModified)
+	}
+
+	err = storage.Write("slot1.sav", make([]byte, 1<<20))
+	var quotaErr *StorageQuotaError
+	if errors.As(err, &quotaErr) {
+		fmt.Printf("cloud saves are full: %d of %d bytes used\n", quotaErr.Used, quotaErr.Quota)
+	}
+	// No Output: (documentation only)
+}
+
+// ExampleStorageUsage shows the totals and the largest and oldest files reported
+// for a set of stored files.
+func ExampleStorageUsage() {
+	var stats []core.FileStat
+	for i, size := range []uint64{30, 10, 70, 50, 20, 60, 40} {
+		stats = append(stats, core.FileStat{
+			Filename:     fmt.Sprintf("file%d", i),
+			Size:         size,
+			LastModified: uint64(1700000000 - i*(i%3)),
+		})
+	}
+	usage := newStorageUsage(stats, 300)
+	fmt.Println(usage.Files, usage.TotalBytes, usage.Remaining())
+	fmt.Println(fileNames(usage.Largest))
+	fmt.Println(fileNames(usage.Oldest))
+
+	fmt.Println(newStorageUsage(stats, 200).Remaining(), newStorageUsage(stats, 0).Remaining())
+	fmt.Println(len(newStorageUsage(nil, 0).Largest))
+	// Output:
+	// 7 280 20
+	// [file2 file5 file3 file6 file0]
+	// [file5 file2 file4 file1 file0]
+	// 0 0
+	// 0
+}
+
+func fileNames(stats []core.FileStat) []string {
+	names := make([]string, len(stats))
+	for i, stat := range stats {
+		names[i] = stat.Filename
+	}
+	return names
+}
+
+// ExampleStorageClient_WithQuota shows that a write may fill the quota exactly, and
+// that the file being replaced does not count against it.
+func ExampleStorageClient_WithQuota() {
+	storage := (&StorageClient{manager: newMemStorage()}).WithQuota(100)
+	fmt.Println(storage.Write("a.sav", make([]byte, 60)))
+	fmt.Println(storage.Write("b.sav", make([]byte, 40)))
+	fmt.Println(storage.Write("c.sav", make([]byte, 1)))
+	fmt.Println(storage.Write("a.sav", make([]byte, 60)))
+	fmt.Println(storage.Write("a.sav", make([]byte, 61)))
+
+	usage, _ := storage.Usage()
+	fmt.Println(usage.Files, usage.TotalBytes, usage.Remaining())
+	// Output:
+	// <nil>
+	// <nil>
+	// writing "c.sav" (1 bytes) exceeds storage quota: 100 of 100 bytes used
+	// <nil>
+	// writing "a.sav" (61 bytes) exceeds storage quota: 40 of 100 bytes used
+	// 2 100 0
+}
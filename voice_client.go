@@ -1,6 +1,7 @@
 package discord
 
 import (
+	"context"
 	"fmt"
 
 	core "github.com/andresperezl/discordgamesdk-go/core"
@@ -12,7 +13,9 @@ type VoiceClient struct {
 	core    *core.Core
 }
 
-// SetInputMode sets the input mode
+// SetInputMode sets the input mode without waiting for Discord to apply it
+//
+// Deprecated: use SetInputModeWithContext, which validates the mode and reports the SDK result.
 func (vc *VoiceClient) SetInputMode(mode core.InputMode) error {
 	if vc.manager == nil {
 		return fmt.Errorf("voice manager not available")
@@ -24,6 +27,48 @@ func (vc *VoiceClient) SetInputMode(mode core.InputMode) error {
 	return nil
 }
 
+// SetInputModeWithContext validates mode and sets it, waiting for Discord to apply it and
+// respecting context cancellation and timeout. Push-to-talk shortcuts are sent in canonical form.
+//
+// Example usage:
+//
+//	shortcut, err := discord.ParseShortcut("ctrl + t")
+//	if err != nil {
+//	    log.Fatalf("invalid shortcut: %v", err)
+//	}
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	if err := client.Voice().SetInputModeWithContext(ctx, discord.PushToTalk(shortcut)); err != nil {
+//	    log.Fatalf("failed to set input mode: %v", err)
+//	}
+//
+// Returns an error if the mode is invalid, the context is cancelled, deadline exceeded, or the SDK rejects the mode.
+func (vc *VoiceClient) SetInputModeWithContext(ctx context.Context, mode core.InputMode) error {
+	if vc.manager == nil {
+		return fmt.Errorf("voice manager not available")
+	}
+	mode, err := ValidateInputMode(mode)
+	if err != nil {
+		return err
+	}
+	errChan := make(chan error, 1)
+
+	vc.manager.SetInputModeAsync(mode, func(result core.Result) {
+		if result != core.ResultOk {
+			errChan <- fmt.Errorf("failed to set input mode: %v", result)
+			return
+		}
+		errChan <- nil
+	})
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // GetInputMode gets the input mode
 func (vc *VoiceClient) GetInputMode() (core.InputMode, error) {
 	if vc.manager == nil {
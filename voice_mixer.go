@@ -0,0 +1,346 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+const (
+	// defaultVoiceMixerFile is the storage file used when VoiceMixerOptions.File is not set
+	defaultVoiceMixerFile = "voice_mixer.json"
+	// DefaultVoiceVolume is Discord's default local volume for a user
+	DefaultVoiceVolume uint8 = 100
+	// MaxVoiceVolume is the highest local volume Discord accepts
+	MaxVoiceVolume uint8 = 200
+)
+
+// VoicePreference is the local mute and volume the current user chose for another user
+type VoicePreference struct {
+	Muted  bool  `json:"muted"`
+	Volume uint8 `json:"volume"`
+}
+
+// MemberFilter selects lobby members whose metadata Key equals Value, e.g. {Key: "team", Value: "red"}
+type MemberFilter struct {
+	Key   string
+	Value string
+}
+
+// VoiceMixerOptions configures a VoiceMixer
+type VoiceMixerOptions struct {
+	// Storage persists preferences when set
+	Storage *StorageClient
+	// File is the storage file holding preferences. Defaults to "voice_mixer.json".
+	File string
+}
+
+// VoiceMixer applies local mute and volume to lobby members, by whole lobby or by
+// member metadata, and remembers the choice per user ID so it is reapplied
+// when the user reconnects. It is safe for concurrent use.
+type VoiceMixer struct {
+	members   func(lobbyID int64) ([]int64, error)
+	metadata  func(lobbyID, userID int64, key string) (string, error)
+	setMute   func(userID int64, muted bool) error
+	setVolume func(userID int64, volume uint8) error
+	save      func(ctx context.Context, prefs map[int64]VoicePreference) error
+
+	mu    sync.Mutex
+	prefs map[int64]VoicePreference // keyed by user ID
+
+	unsubscribe func()
+	wake        chan struct{}
+	stop        chan struct{}
+	closeOnce   sync.Once
+
+	joinMu sync.Mutex
+	joined []int64 // connected users waiting for reapply, in connect order
+	err    error   // result of the most recent reapply
+}
+
+// NewVoiceMixer creates a mixer and loads previously saved preferences from opts.Storage.
+// The mixer reapplies saved preferences whenever a lobby member connects, until Close.
+//
+// Example usage:
+//
+//	mixer, err := discord.NewVoiceMixer(client.Voice(), client.Lobby(), discord.VoiceMixerOptions{
+//	    Storage: client.Storage(),
+//	})
+//	if err != nil {
+//	    log.Fatalf("failed to create mixer: %v", err)
+//	}
+//	defer mixer.Close()
+//	// Mute the other team
+//	err = mixer.MuteMembers(ctx, lobbyID, &discord.MemberFilter{Key: "team", Value: "blue"}, true)
+//
+// Returns an error if the saved preferences cannot be read.
+func NewVoiceMixer(voice *VoiceClient, lobby *LobbyClient, opts VoiceMixerOptions) (*VoiceMixer, error) {
+	if opts.File == "" {
+		opts.File = defaultVoiceMixerFile
+	}
+	m := newVoiceMixer(
+		func(lobbyID int64) ([]int64, error) { return lobbyMembers(lobby, lobbyID) },
+		lobby.GetLobbyMemberMetadataValue,
+		voice.SetLocalMute,
+		voice.SetLocalVolume,
+	)
+	if opts.Storage != nil {
+		m.save = func(ctx context.Context, prefs map[int64]VoicePreference) error {
+			return SaveJSON(ctx, opts.Storage, opts.File, prefs)
+		}
+		exists, err := opts.Storage.Exists(opts.File)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			prefs, err := LoadJSON[map[int64]VoicePreference](opts.Storage, opts.File)
+			if err != nil {
+				return nil, err
+			}
+			if prefs != nil {
+				m.prefs = prefs
+			}
+		}
+	}
+	if lobby != nil && lobby.core != nil {
+		m.listen(lobby.core)
+	}
+	return m, nil
+}
+
+func newVoiceMixer(
+	members func(lobbyID int64) ([]int64, error),
+	metadata func(lobbyID, userID int64, key string) (string, error),
+	setMute func(userID int64, muted bool) error,
+	setVolume func(userID int64, volume uint8) error,
+) *VoiceMixer {
+	return &VoiceMixer{
+		members:   members,
+		metadata:  metadata,
+		setMute:   setMute,
+		setVolume: setVolume,
+		prefs:     make(map[int64]VoicePreference),
+	}
+}
+
+// Preference returns the saved preference for a user
+func (m *VoiceMixer) Preference(userID int64) (VoicePreference, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pref, ok := m.prefs[userID]
+	return pref, ok
+}
+
+// Members returns the user IDs of the lobby members matching filter. A nil filter matches every member;
+// members without the filter's key do not match.
+//
+// Returns an error if the members or their metadata cannot be read.
+func (m *VoiceMixer) Members(lobbyID int64, filter *MemberFilter) ([]int64, error) {
+	members, err := m.members(lobbyID)
+	if err != nil {
+		return nil, err
+	}
+	if filter == nil {
+		return members, nil
+	}
+	var matches []int64
+	for _, userID := range members {
+		value, err := m.metadata(lobbyID, userID, filter.Key)
+		if errors.Is(err, ErrMetadataNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if value == filter.Value {
+			matches = append(matches, userID)
+		}
+	}
+	return matches, nil
+}
+
+// MuteUser locally mutes or unmutes a user and saves the preference
+func (m *VoiceMixer) MuteUser(ctx context.Context, userID int64, muted bool) error {
+	return m.apply(ctx, []int64{userID}, func(pref *VoicePreference) { pref.Muted = muted })
+}
+
+// SetUserVolume sets a user's local volume (0-200, 100 is normal) and saves the preference
+func (m *VoiceMixer) SetUserVolume(ctx context.Context, userID int64, volume uint8) error {
+	if volume > MaxVoiceVolume {
+		return fmt.Errorf("volume %d is above the maximum of %d", volume, MaxVoiceVolume)
+	}
+	return m.apply(ctx, []int64{userID}, func(pref *VoicePreference) { pref.Volume = volume })
+}
+
+// MuteMembers locally mutes or unmutes the lobby members matching filter and saves the preferences.
+// A nil filter applies to every member.
+func (m *VoiceMixer) MuteMembers(ctx context.Context, lobbyID int64, filter *MemberFilter, muted bool) error {
+	members, err := m.Members(lobbyID, filter)
+	if err != nil {
+		return err
+	}
+	return m.apply(ctx, members, func(pref *VoicePreference) { pref.Muted = muted })
+}
+
+// SetMembersVolume sets the local volume (0-200, 100 is normal) of the lobby members matching
+// filter and saves the preferences. A nil filter applies to every member.
+func (m *VoiceMixer) SetMembersVolume(ctx context.Context, lobbyID int64, filter *MemberFilter, volume uint8) error {
+	if volume > MaxVoiceVolume {
+		return fmt.Errorf("volume %d is above the maximum of %d", volume, MaxVoiceVolume)
+	}
+	members, err := m.Members(lobbyID, filter)
+	if err != nil {
+		return err
+	}
+	return m.apply(ctx, members, func(pref *VoicePreference) { pref.Volume = volume })
+}
+
+// Reset forgets the preference for a user and restores Discord's defaults
+func (m *VoiceMixer) Reset(ctx context.Context, userID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.prefs, userID)
+	if err := m.push(userID, VoicePreference{Volume: DefaultVoiceVolume}); err != nil {
+		return err
+	}
+	return m.persist(ctx)
+}
+
+// MemberConnected reapplies the saved preference for a user who joined or rejoined a lobby.
+// Users without a saved preference are left untouched.
+func (m *VoiceMixer) MemberConnected(userID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pref, ok := m.prefs[userID]
+	if !ok {
+		return nil
+	}
+	return m.push(userID, pref)
+}
+
+// ApplyLobby reapplies saved preferences to every member currently in a lobby
+func (m *VoiceMixer) ApplyLobby(lobbyID int64) error {
+	members, err := m.Members(lobbyID, nil)
+	if err != nil {
+		return err
+	}
+	for _, userID := range members {
+		if err := m.MemberConnected(userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Err returns the error from the most recent reapply on a member connect, or nil if it succeeded
+func (m *VoiceMixer) Err() error {
+	m.joinMu.Lock()
+	defer m.joinMu.Unlock()
+	return m.err
+}
+
+// Close stops reapplying preferences on member connects. Other subscribers to the
+// lobby events are not affected.
+func (m *VoiceMixer) Close() {
+	m.closeOnce.Do(func() {
+		if m.unsubscribe != nil {
+			m.unsubscribe()
+			close(m.stop)
+		}
+	})
+}
+
+// listen subscribes the mixer to the member connects of source until Close
+func (m *VoiceMixer) listen(source eventSource) {
+	m.wake = make(chan struct{}, 1)
+	m.stop = make(chan struct{})
+	go m.reapply()
+	m.unsubscribe = source.AddLobbyEvents(&core.LobbyEvents{
+		// Pushing a preference waits for the SDK thread this handler runs on, so
+		// the connect is queued for reapply instead of being handled here
+		OnMemberConnect: func(lobbyID, userID int64) {
+			m.joinMu.Lock()
+			m.joined = append(m.joined, userID)
+			m.joinMu.Unlock()
+			select {
+			case m.wake <- struct{}{}:
+			default:
+			}
+		},
+	})
+}
+
+// reapply calls MemberConnected for queued connects, in order, until Close
+func (m *VoiceMixer) reapply() {
+	for {
+		select {
+		case <-m.wake:
+		case <-m.stop:
+			return
+		}
+		m.joinMu.Lock()
+		joined := m.joined
+		m.joined = nil
+		m.joinMu.Unlock()
+		for _, userID := range joined {
+			err := m.MemberConnected(userID)
+			m.joinMu.Lock()
+			m.err = err
+			m.joinMu.Unlock()
+		}
+	}
+}
+
+// apply updates the preferences of users with change, pushes them to Discord and saves them
+func (m *VoiceMixer) apply(ctx context.Context, users []int64, change func(pref *VoicePreference)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, userID := range users {
+		pref, ok := m.prefs[userID]
+		if !ok {
+			pref.Volume = DefaultVoiceVolume
+		}
+		change(&pref)
+		if err := m.push(userID, pref); err != nil {
+			return err
+		}
+		m.prefs[userID] = pref
+	}
+	return m.persist(ctx)
+}
+
+// push applies a preference through the voice manager. Callers must hold m.mu.
+func (m *VoiceMixer) push(userID int64, pref VoicePreference) error {
+	if err := m.setMute(userID, pref.Muted); err != nil {
+		return err
+	}
+	return m.setVolume(userID, pref.Volume)
+}
+
+// persist saves the preferences when storage is configured. Callers must hold m.mu.
+func (m *VoiceMixer) persist(ctx context.Context) error {
+	if m.save == nil {
+		return nil
+	}
+	return m.save(ctx, m.prefs)
+}
+
+// lobbyMembers lists the user IDs of every member of a lobby
+func lobbyMembers(lobby *LobbyClient, lobbyID int64) ([]int64, error) {
+	count, err := lobby.GetLobbyMemberCount(lobbyID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]int64, 0, count)
+	for i := int32(0); i < count; i++ {
+		userID, err := lobby.GetLobbyMemberUserId(lobbyID, i)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, userID)
+	}
+	return members, nil
+}
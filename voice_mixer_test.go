@@ -0,0 +1,89 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+)
+
+// ExampleVoiceMixer demonstrates muting a team and reapplying preferences when a member reconnects.
+func ExampleVoiceMixer() {
+	teams := map[int64]string{1: "red", 2: "blue", 3: "blue"}
+	m := newVoiceMixer(
+		func(lobbyID int64) ([]int64, error) { return []int64{1, 2, 3, 4}, nil },
+		func(lobbyID, userID int64, key string) (string, error) {
+			team, ok := teams[userID]
+			if !ok {
+				return "", ErrMetadataNotFound // user 4 has not picked a team
+			}
+			return team, nil
+		},
+		func(userID int64, muted bool) error {
+			fmt.Printf("user %d muted=%v\n", userID, muted)
+			return nil
+		},
+		func(userID int64, volume uint8) error { return nil },
+	)
+	ctx := context.Background()
+
+	_ = m.MuteMembers(ctx, 42, &MemberFilter{Key: "team", Value: "blue"}, true)
+	_ = m.SetUserVolume(ctx, 1, 150)
+
+	fmt.Println("user 3 reconnects")
+	_ = m.MemberConnected(3)
+
+	pref, _ := m.Preference(1)
+	fmt.Printf("user 1 volume=%d muted=%v\n", pref.Volume, pref.Muted)
+	// Output:
+	// user 2 muted=true
+	// user 3 muted=true
+	// user 1 muted=false
+	// user 3 reconnects
+	// user 3 muted=true
+	// user 1 volume=150 muted=false
+}
+
+// ExampleVoiceMixer_Members demonstrates that metadata errors other than a missing key are returned.
+func ExampleVoiceMixer_Members() {
+	m := newVoiceMixer(
+		func(lobbyID int64) ([]int64, error) { return []int64{1, 2}, nil },
+		func(lobbyID, userID int64, key string) (string, error) {
+			return "", fmt.Errorf("lobby manager not available")
+		},
+		func(userID int64, muted bool) error { return nil },
+		func(userID int64, volume uint8) error { return nil },
+	)
+
+	_, err := m.Members(42, &MemberFilter{Key: "team", Value: "red"})
+	fmt.Println(err)
+	// Output:
+	// lobby manager not available
+}
+
+// ExampleVoiceMixer_reconnect demonstrates saved preferences being reapplied on OnMemberConnect events.
+func ExampleVoiceMixer_reconnect() {
+	events := &testEvents{}
+	pushed := make(chan string, 4)
+	m := newVoiceMixer(
+		func(lobbyID int64) ([]int64, error) { return nil, nil },
+		func(lobbyID, userID int64, key string) (string, error) { return "", ErrMetadataNotFound },
+		func(userID int64, muted bool) error {
+			pushed <- fmt.Sprintf("user %d muted=%v", userID, muted)
+			return nil
+		},
+		func(userID int64, volume uint8) error { return nil },
+	)
+	m.listen(events)
+	defer m.Close()
+
+	_ = m.MuteUser(context.Background(), 7, true)
+	fmt.Println(<-pushed)
+
+	events.memberConnect(42, 8) // no saved preference, left untouched
+	events.memberConnect(42, 7)
+	fmt.Println(<-pushed)
+	fmt.Println(m.Err())
+	// Output:
+	// user 7 muted=true
+	// user 7 muted=true
+	// <nil>
+}
@@ -0,0 +1,174 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// maxShortcutLength is the longest shortcut that fits in the SDK's DiscordInputMode, excluding the NUL terminator
+const maxShortcutLength = 255
+
+// ShortcutModifier is a set of modifier keys held for a push-to-talk shortcut
+type ShortcutModifier uint8
+
+const (
+	ModifierCtrl ShortcutModifier = 1 << iota
+	ModifierAlt
+	ModifierShift
+	ModifierMeta
+)
+
+// modifierNames lists modifiers in the order they are formatted
+var modifierNames = []struct {
+	mod  ShortcutModifier
+	name string
+}{
+	{ModifierCtrl, "ctrl"},
+	{ModifierAlt, "alt"},
+	{ModifierShift, "shift"},
+	{ModifierMeta, "meta"},
+}
+
+// modifierAliases maps every accepted modifier spelling to its modifier
+var modifierAliases = map[string]ShortcutModifier{
+	"ctrl":    ModifierCtrl,
+	"control": ModifierCtrl,
+	"alt":     ModifierAlt,
+	"option":  ModifierAlt,
+	"shift":   ModifierShift,
+	"meta":    ModifierMeta,
+	"cmd":     ModifierMeta,
+	"command": ModifierMeta,
+	"super":   ModifierMeta,
+	"win":     ModifierMeta,
+}
+
+// shortcutKeys are the non-modifier key names Discord accepts in shortcuts
+var shortcutKeys = func() map[string]bool {
+	keys := map[string]bool{}
+	for c := 'a'; c <= 'z'; c++ {
+		keys[string(c)] = true
+	}
+	for c := '0'; c <= '9'; c++ {
+		keys[string(c)] = true
+		keys["numpad "+string(c)] = true
+	}
+	for i := 1; i <= 24; i++ {
+		keys[fmt.Sprintf("f%d", i)] = true
+	}
+	for _, name := range []string{
+		"backspace", "tab", "enter", "escape", "space", "caps lock", "num lock", "scroll lock",
+		"print screen", "pause", "insert", "delete", "home", "end", "page up", "page down",
+		"left", "up", "right", "down",
+		"numpad *", "numpad +", "numpad -", "numpad .", "numpad /",
+		";", "=", ",", "-", ".", "/", "`", "[", "\\", "]", "'",
+	} {
+		keys[name] = true
+	}
+	return keys
+}()
+
+// Shortcut is a push-to-talk key combination such as "ctrl + shift + t".
+// Key may be empty when the shortcut is a lone modifier such as "shift".
+type Shortcut struct {
+	Modifiers ShortcutModifier
+	Key       string
+}
+
+// ParseShortcut parses a key combination written as key names joined by "+".
+// Names are case-insensitive and common modifier aliases such as "control" and "cmd" are accepted.
+//
+// Example usage:
+//
+//	shortcut, err := discord.ParseShortcut("Ctrl+Shift+T")
+//	if err != nil {
+//	    log.Fatalf("invalid shortcut: %v", err)
+//	}
+//	fmt.Println(shortcut) // ctrl + shift + t
+func ParseShortcut(s string) (Shortcut, error) {
+	var shortcut Shortcut
+	parts := strings.Split(strings.ToLower(s), "+")
+	// A trailing "+" is the key itself, as in "ctrl + numpad +"
+	if len(parts) > 1 && strings.TrimSpace(parts[len(parts)-1]) == "" {
+		parts = parts[:len(parts)-1]
+		parts[len(parts)-1] += "+"
+	}
+	for i, part := range parts {
+		name := strings.Join(strings.Fields(part), " ")
+		if name == "" {
+			return Shortcut{}, fmt.Errorf("invalid shortcut %q: empty key name", s)
+		}
+		if mod, ok := modifierAliases[name]; ok {
+			if shortcut.Modifiers&mod != 0 {
+				return Shortcut{}, fmt.Errorf("invalid shortcut %q: duplicate modifier %q", s, name)
+			}
+			shortcut.Modifiers |= mod
+			continue
+		}
+		if i != len(parts)-1 {
+			return Shortcut{}, fmt.Errorf("invalid shortcut %q: key %q must come after the modifiers", s, name)
+		}
+		shortcut.Key = name
+	}
+	if err := shortcut.Validate(); err != nil {
+		return Shortcut{}, fmt.Errorf("invalid shortcut %q: %v", s, err)
+	}
+	return shortcut, nil
+}
+
+// String formats the shortcut the way Discord expects it, modifiers first, e.g. "ctrl + shift + t"
+func (s Shortcut) String() string {
+	var names []string
+	for _, m := range modifierNames {
+		if s.Modifiers&m.mod != 0 {
+			names = append(names, m.name)
+		}
+	}
+	if s.Key != "" {
+		names = append(names, s.Key)
+	}
+	return strings.Join(names, " + ")
+}
+
+// Validate reports whether the shortcut names a known key and fits in the SDK's shortcut field
+func (s Shortcut) Validate() error {
+	if s.Key == "" && s.Modifiers == 0 {
+		return fmt.Errorf("shortcut is empty")
+	}
+	if s.Key != "" && !shortcutKeys[s.Key] {
+		return fmt.Errorf("unknown key %q", s.Key)
+	}
+	if len(s.String()) > maxShortcutLength {
+		return fmt.Errorf("shortcut is longer than %d bytes", maxShortcutLength)
+	}
+	return nil
+}
+
+// PushToTalk returns a push-to-talk input mode using shortcut
+func PushToTalk(shortcut Shortcut) core.InputMode {
+	return core.InputMode{Type: core.InputModeTypePushToTalk, Shortcut: shortcut.String()}
+}
+
+// VoiceActivity returns a voice activity input mode
+func VoiceActivity() core.InputMode {
+	return core.InputMode{Type: core.InputModeTypeVoiceActivity}
+}
+
+// ValidateInputMode checks that mode has a known type and, for push-to-talk, a valid shortcut.
+// It returns the mode with its shortcut in canonical form.
+func ValidateInputMode(mode core.InputMode) (core.InputMode, error) {
+	switch mode.Type {
+	case core.InputModeTypeVoiceActivity:
+		return mode, nil
+	case core.InputModeTypePushToTalk:
+		shortcut, err := ParseShortcut(mode.Shortcut)
+		if err != nil {
+			return mode, err
+		}
+		return PushToTalk(shortcut), nil
+	default:
+		return mode, fmt.Errorf("unknown input mode type %d", mode.Type)
+	}
+}
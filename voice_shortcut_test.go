@@ -0,0 +1,22 @@
+package discord
+
+import "fmt"
+
+// ExampleParseShortcut demonstrates parsing and formatting push-to-talk shortcuts.
+func ExampleParseShortcut() {
+	for _, s := range []string{"Shift+Control+T", "caps   lock", "cmd + numpad +", "shift", "ctrl + banana", "t + ctrl"} {
+		shortcut, err := ParseShortcut(s)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(shortcut)
+	}
+	// Output:
+	// ctrl + shift + t
+	// caps lock
+	// meta + numpad +
+	// shift
+	// invalid shortcut "ctrl + banana": unknown key "banana"
+	// invalid shortcut "t + ctrl": key "t" must come after the modifiers
+}
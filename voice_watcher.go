@@ -0,0 +1,200 @@
+package discord
+
+import (
+	"fmt"
+	"sync"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// VoiceState is a snapshot of the current user's voice settings
+type VoiceState struct {
+	InputMode core.InputMode
+	SelfMute  bool
+	SelfDeaf  bool
+}
+
+// State reads the current voice settings
+func (vc *VoiceClient) State() (VoiceState, error) {
+	mode, err := vc.GetInputMode()
+	if err != nil {
+		return VoiceState{}, err
+	}
+	mute, err := vc.IsSelfMute()
+	if err != nil {
+		return VoiceState{}, err
+	}
+	deaf, err := vc.IsSelfDeaf()
+	if err != nil {
+		return VoiceState{}, err
+	}
+	return VoiceState{InputMode: mode, SelfMute: mute, SelfDeaf: deaf}, nil
+}
+
+// VoiceWatcher keeps a snapshot of the voice settings in sync with the voice
+// manager's OnSettingsUpdate event and emits the full state whenever it changes.
+type VoiceWatcher struct {
+	read        func() (VoiceState, error)
+	unsubscribe func()
+
+	requests chan chan error // refresh requests, served one at a time by run
+	stop     chan struct{}
+	done     chan struct{}
+
+	mu      sync.RWMutex
+	state   VoiceState
+	err     error
+	changes broadcaster[VoiceState]
+}
+
+// Watch reads the current voice settings and returns a watcher that re-reads
+// them every time Discord reports a settings update.
+//
+// Example usage:
+//
+//	watcher, err := client.Voice().Watch()
+//	if err != nil {
+//	    log.Fatalf("failed to watch voice settings: %v", err)
+//	}
+//	defer watcher.Close()
+//	hud.SetMuted(watcher.State().SelfMute)
+//	go func() {
+//	    for state := range watcher.Changes() {
+//	        hud.SetMuted(state.SelfMute)
+//	        hud.SetDeafened(state.SelfDeaf)
+//	    }
+//	}()
+//
+// Returns an error if the voice manager is not available or the settings cannot be read.
+func (vc *VoiceClient) Watch() (*VoiceWatcher, error) {
+	if vc.manager == nil {
+		return nil, fmt.Errorf("voice manager not available")
+	}
+
+	w := newVoiceWatcher(vc.State)
+	// Subscribe before the first read so updates raised while it runs are not missed
+	if vc.core != nil {
+		w.listen(vc.core)
+	}
+	if err := w.Refresh(); err != nil {
+		w.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func newVoiceWatcher(read func() (VoiceState, error)) *VoiceWatcher {
+	w := &VoiceWatcher{
+		read:     read,
+		requests: make(chan chan error, 1),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+// listen subscribes the watcher to the voice events of source until Close
+func (w *VoiceWatcher) listen(source eventSource) {
+	w.unsubscribe = source.AddVoiceEvents(&core.VoiceEvents{
+		// The handler must not block the SDK thread, so the settings are read by run.
+		// If a request is already pending, its read happens after this update and covers it.
+		OnSettingsUpdate: func() {
+			select {
+			case w.requests <- nil:
+			default:
+			}
+		},
+	})
+}
+
+// run serves refresh requests one at a time, so reads never overlap and are applied
+// in the order they were requested
+func (w *VoiceWatcher) run() {
+	defer close(w.done)
+	for {
+		select {
+		case reply := <-w.requests:
+			err := w.refresh()
+			if reply != nil {
+				reply <- err
+			}
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+// Refresh re-reads the voice settings and emits the new state if it differs from the snapshot
+//
+// Returns an error if the watcher is closed or the settings cannot be read.
+func (w *VoiceWatcher) Refresh() error {
+	reply := make(chan error, 1)
+	select {
+	case w.requests <- reply:
+	case <-w.stop:
+		return fmt.Errorf("voice watcher closed")
+	}
+	select {
+	case err := <-reply:
+		return err
+	case <-w.done:
+		return fmt.Errorf("voice watcher closed")
+	}
+}
+
+func (w *VoiceWatcher) refresh() error {
+	state, err := w.read()
+	w.mu.Lock()
+	w.err = err
+	w.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	w.update(state)
+	return nil
+}
+
+// State returns the latest known voice settings
+func (w *VoiceWatcher) State() VoiceState {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.state
+}
+
+// Err returns the error from the most recent read of the voice settings, or nil if it succeeded.
+// Reads triggered by settings updates report failures only here; State keeps the last good state.
+func (w *VoiceWatcher) Err() error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.err
+}
+
+// Changes returns a channel that receives the full voice state after every change.
+// Each call returns a new channel; all channels are closed by Close.
+func (w *VoiceWatcher) Changes() <-chan VoiceState {
+	return w.changes.subscribe(8)
+}
+
+// Close stops delivering changes and closes all channels returned by Changes.
+// Other subscribers to the voice events are not affected.
+func (w *VoiceWatcher) Close() {
+	if !w.changes.close() {
+		return
+	}
+	if w.unsubscribe != nil {
+		w.unsubscribe()
+	}
+	close(w.stop)
+}
+
+// update stores state and emits it if it differs from the snapshot
+func (w *VoiceWatcher) update(state VoiceState) {
+	w.mu.Lock()
+	changed := state != w.state
+	w.state = state
+	w.mu.Unlock()
+	if changed {
+		w.changes.emit(state)
+	}
+}
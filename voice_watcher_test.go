@@ -0,0 +1,53 @@
+package discord
+
+import (
+	"fmt"
+
+	core "github.com/andresperezl/discordgamesdk-go/core"
+)
+
+// ExampleVoiceWatcher demonstrates that only actual settings changes are emitted.
+func ExampleVoiceWatcher() {
+	settings := VoiceState{InputMode: VoiceActivity()}
+	w := newVoiceWatcher(func() (VoiceState, error) { return settings, nil })
+	_ = w.Refresh()
+	changes := w.Changes()
+
+	settings.SelfMute = true
+	_ = w.Refresh()
+	_ = w.Refresh() // unchanged, nothing emitted
+	settings.InputMode = core.InputMode{Type: core.InputModeTypePushToTalk, Shortcut: "ctrl + t"}
+	_ = w.Refresh()
+	w.Close()
+
+	for state := range changes {
+		fmt.Printf("mode=%d shortcut=%q mute=%v deaf=%v\n", state.InputMode.Type, state.InputMode.Shortcut, state.SelfMute, state.SelfDeaf)
+	}
+	// Output:
+	// mode=0 shortcut="" mute=true deaf=false
+	// mode=1 shortcut="ctrl + t" mute=true deaf=false
+}
+
+// ExampleVoiceWatcher_settingsUpdate demonstrates how OnSettingsUpdate events refresh the
+// snapshot and how failed reads are reported through Err.
+func ExampleVoiceWatcher_settingsUpdate() {
+	events := &testEvents{}
+	settings := VoiceState{InputMode: VoiceActivity()}
+	var readErr error
+	w := newVoiceWatcher(func() (VoiceState, error) { return settings, readErr })
+	w.listen(events)
+	defer w.Close()
+	changes := w.Changes()
+
+	settings.SelfDeaf = true
+	events.voiceSettingsUpdate()
+	fmt.Println("deaf:", (<-changes).SelfDeaf)
+
+	readErr = fmt.Errorf("voice manager not available")
+	fmt.Println(w.Refresh(), w.State().SelfDeaf)
+	fmt.Println(w.Err())
+	// Output:
+	// deaf: true
+	// voice manager not available true
+	// voice manager not available
+}